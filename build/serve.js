@@ -37,7 +37,7 @@ export const browserSyncInstance = browserSync.create();
  */
 const backendDevArgs = [
   `--apiserver-host=${conf.backend.apiServerHost}`,
-  `--port=${conf.backend.devServerPort}`,
+  `--insecure-port=${conf.backend.devServerPort}`,
   `--heapster-host=${conf.backend.heapsterServerHost}`,
 ];
 
@@ -48,7 +48,7 @@ const backendDevArgs = [
  */
 const backendArgs = [
   `--apiserver-host=${conf.backend.apiServerHost}`,
-  `--port=${conf.frontend.serverPort}`,
+  `--insecure-port=${conf.frontend.serverPort}`,
   `--heapster-host=${conf.backend.heapsterServerHost}`,
 ];
 
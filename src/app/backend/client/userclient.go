@@ -0,0 +1,135 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/client/restclient"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
+)
+
+// AuthenticationMode decides whose credentials are used to talk to the Apiserver.
+type AuthenticationMode string
+
+const (
+	// SharedAuthenticationMode makes all users act with the credentials of Dashboard UI itself.
+	SharedAuthenticationMode AuthenticationMode = "shared"
+
+	// PerUserAuthenticationMode makes every request act with the bearer token of the user that
+	// made it, so that the Apiserver authorizes each user separately.
+	PerUserAuthenticationMode AuthenticationMode = "per-user"
+
+	// TokenCookieName is the name of the session cookie that holds the bearer token of a logged
+	// in user.
+	TokenCookieName = "kubernetes-dashboard-token"
+)
+
+// LoginSpec is a specification of a user login request.
+type LoginSpec struct {
+	// Bearer token the user authenticates to the Apiserver with.
+	Token string `json:"token"`
+}
+
+// ErrNoBearerToken is returned when a request that requires user credentials carries none.
+var ErrNoBearerToken = errors.New("Request does not carry bearer token in Authorization " +
+	"header nor session cookie, please log in")
+
+// ParseAuthenticationMode returns authentication mode of the given name or error when there is no
+// such mode.
+func ParseAuthenticationMode(name string) (AuthenticationMode, error) {
+	switch mode := AuthenticationMode(name); mode {
+	case SharedAuthenticationMode, PerUserAuthenticationMode:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("Unknown authentication mode: %s, expected one of: %s, %s", name,
+			SharedAuthenticationMode, PerUserAuthenticationMode)
+	}
+}
+
+// GetBearerToken returns bearer token the request was made with. Token is read from the
+// Authorization header and, when there is none, from the session cookie. Empty string is returned
+// when the request carries no token.
+func GetBearerToken(request *http.Request) string {
+	authorization := request.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	}
+
+	if cookie, err := request.Cookie(TokenCookieName); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+// CreateUserClient creates Kubernetes Apiserver client and client config that connect to the same
+// Apiserver as the given client config, but authenticate with the given bearer token instead of
+// Dashboard UI credentials.
+func CreateUserClient(clientConfig clientcmd.ClientConfig, token string) (*client.Client,
+	clientcmd.ClientConfig, error) {
+
+	userClientConfig := &userClientConfig{config: clientConfig, token: token}
+	cfg, err := userClientConfig.ClientConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := client.New(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, userClientConfig, nil
+}
+
+// Client config that replaces credentials of the underlying config with a bearer token.
+type userClientConfig struct {
+	config clientcmd.ClientConfig
+	token  string
+}
+
+// RawConfig returns the merged result of all overrides of the underlying config.
+func (config *userClientConfig) RawConfig() (clientcmdapi.Config, error) {
+	return config.config.RawConfig()
+}
+
+// Namespace returns the namespace of the underlying config.
+func (config *userClientConfig) Namespace() (string, bool, error) {
+	return config.config.Namespace()
+}
+
+// ClientConfig returns client config with all credentials of the underlying config dropped in
+// favour of the bearer token.
+func (config *userClientConfig) ClientConfig() (*restclient.Config, error) {
+	cfg, err := config.config.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.BearerToken = config.token
+	cfg.Username = ""
+	cfg.Password = ""
+	cfg.CertFile = ""
+	cfg.CertData = nil
+	cfg.KeyFile = ""
+	cfg.KeyData = nil
+	cfg.QPS = defaultQPS
+	cfg.Burst = defaultBurst
+	return cfg, nil
+}
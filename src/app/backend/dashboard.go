@@ -15,6 +15,7 @@
 package main
 
 import (
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"log"
@@ -23,11 +24,28 @@ import (
 	// TODO(maciaszczykm): Avoid using dot-imports.
 	. "github.com/kubernetes/dashboard/client"
 	. "github.com/kubernetes/dashboard/handler"
+	"github.com/kubernetes/dashboard/resource/common"
 	"github.com/spf13/pflag"
+	"k8s.io/kubernetes/pkg/util/wait"
 )
 
 var (
-	argPort          = pflag.Int("port", 9090, "The port to listen to for incoming HTTP requests")
+	argInsecurePort = pflag.Int("insecure-port", 9090, "The port to listen to for incoming "+
+		"plain HTTP requests. Set to 0 to disable serving plain HTTP.")
+	argPort       = pflag.Int("port", 9090, "Deprecated alias of --insecure-port")
+	argSecurePort = pflag.Int("secure-port", 8443, "The port to listen to for incoming HTTPS "+
+		"requests. HTTPS is served when a certificate is given or auto-generated.")
+	argTLSCertFile = pflag.String("tls-cert-file", "", "File containing the PEM encoded "+
+		"certificate to serve HTTPS with, together with --tls-key-file.")
+	argTLSKeyFile = pflag.String("tls-key-file", "", "File containing the PEM encoded "+
+		"private key of the certificate given by --tls-cert-file.")
+	argAutoGenerateCertificates = pflag.Bool("auto-generate-certificates", false, "When set and "+
+		"no certificate is given, HTTPS is served with a self-signed certificate generated on "+
+		"start.")
+	argClientCAFile = pflag.String("client-ca-file", "", "File containing PEM encoded "+
+		"certificate authorities. When set, HTTPS clients have to present a certificate signed "+
+		"by one of them. Requires --insecure-port=0, so that the certificate check cannot be "+
+		"bypassed over plain HTTP.")
 	argApiserverHost = pflag.String("apiserver-host", "", "The address of the Kubernetes Apiserver "+
 		"to connect to in the format of protocol://address:port, e.g., "+
 		"http://localhost:8080. If not specified, the assumption is that the binary runs inside a"+
@@ -36,13 +54,49 @@ var (
 		"to connect to in the format of protocol://address:port, e.g., "+
 		"http://localhost:8082. If not specified, the assumption is that the binary runs inside a"+
 		"Kubernetes cluster and service proxy will be used.")
+	argResourceCacheResyncPeriod = pflag.Duration("resource-cache-resync-period", 0, "When "+
+		"non-zero, resource lists are served from an in-memory cache that is kept up to date "+
+		"by watching the Kubernetes Apiserver and fully resynced with this period, e.g., 5m. "+
+		"Lists are fetched directly from the Apiserver until the cache is synced.")
+	argAuthenticationMode = pflag.String("authentication-mode", "shared", "Whose credentials "+
+		"are used to talk to the Kubernetes Apiserver. In \"shared\" mode all users act with the "+
+		"credentials of the dashboard. In \"per-user\" mode every request is made with the "+
+		"bearer token from its Authorization header or login session cookie, so that the "+
+		"Apiserver authorizes each user separately.")
 )
 
 func main() {
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
+	pflag.CommandLine.MarkDeprecated("port", "use --insecure-port instead")
 	pflag.Parse()
 
-	log.Printf("Starting HTTP server on port %d", *argPort)
+	insecurePort := *argInsecurePort
+	if pflag.CommandLine.Changed("port") {
+		insecurePort = *argPort
+	}
+
+	tlsConfig, err := CreateTLSConfig(TLSConfigSpec{
+		CertFile:                 *argTLSCertFile,
+		KeyFile:                  *argTLSKeyFile,
+		AutoGenerateCertificates: *argAutoGenerateCertificates,
+		ClientCAFile:             *argClientCAFile,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if tlsConfig == nil && insecurePort == 0 {
+		log.Fatal("Insecure port is disabled and no certificate to serve HTTPS with is given")
+	}
+	if *argClientCAFile != "" && insecurePort != 0 {
+		// Plain HTTP serves the same API without any client certificate.
+		log.Fatal("Client certificate authentication requires the insecure port to be disabled " +
+			"with --insecure-port=0")
+	}
+
+	authenticationMode, err := ParseAuthenticationMode(*argAuthenticationMode)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	apiserverClient, config, err := CreateApiserverClient(*argApiserverHost)
 	if err != nil {
@@ -55,6 +109,16 @@ func main() {
 	}
 	log.Printf("Successful initial request to the apiserver, version: %s", versionInfo.String())
 
+	if *argResourceCacheResyncPeriod > 0 && authenticationMode == PerUserAuthenticationMode {
+		// Cached lists are fetched with the dashboard credentials and would bypass authorization
+		// of users.
+		log.Print("Resource cache is not used in per-user authentication mode")
+	} else if *argResourceCacheResyncPeriod > 0 {
+		resourceCache := common.NewResourceCache(apiserverClient, *argResourceCacheResyncPeriod)
+		resourceCache.Run(wait.NeverStop)
+		common.UseResourceCache(resourceCache)
+	}
+
 	heapsterRESTClient, err := CreateHeapsterRESTClient(*argHeapsterHost, apiserverClient)
 	if err != nil {
 		log.Print("Could not create heapster client: %s. Continuing.", err)
@@ -63,10 +127,30 @@ func main() {
 	// Run a HTTP server that serves static public files from './public' and handles API calls.
 	// TODO(bryk): Disable directory listing.
 	http.Handle("/", http.FileServer(http.Dir("./public")))
-	http.Handle("/api/", CreateHttpApiHandler(apiserverClient, heapsterRESTClient, config))
+	http.Handle("/api/", CreateHttpApiHandler(apiserverClient, heapsterRESTClient, config,
+		authenticationMode))
 	// TODO(maciaszczykm): Move to /appConfig.json as it was discussed in #640.
 	http.Handle("/api/appConfig.json", AppHandler(ConfigHandler))
-	log.Print(http.ListenAndServe(fmt.Sprintf(":%d", *argPort), nil))
+
+	serveErrors := make(chan error)
+	if insecurePort > 0 {
+		go func() {
+			log.Printf("Starting HTTP server on port %d", insecurePort)
+			serveErrors <- http.ListenAndServe(fmt.Sprintf(":%d", insecurePort), nil)
+		}()
+	}
+	if tlsConfig != nil {
+		go func() {
+			log.Printf("Starting HTTPS server on port %d", *argSecurePort)
+			listener, err := tls.Listen("tcp", fmt.Sprintf(":%d", *argSecurePort), tlsConfig)
+			if err != nil {
+				serveErrors <- err
+				return
+			}
+			serveErrors <- http.Serve(listener, nil)
+		}()
+	}
+	log.Print(<-serveErrors)
 }
 
 /**
@@ -15,18 +15,32 @@
 package handler
 
 import (
+	"bufio"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	restful "github.com/emicklei/go-restful"
+	"golang.org/x/net/websocket"
 	// TODO(maciaszczykm): Avoid using dot-imports.
 	. "github.com/kubernetes/dashboard/client"
 	. "github.com/kubernetes/dashboard/resource/container"
+	"github.com/kubernetes/dashboard/resource/daemonset"
 	"github.com/kubernetes/dashboard/resource/deployment"
 	. "github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/ingress"
+	"github.com/kubernetes/dashboard/resource/job"
 	. "github.com/kubernetes/dashboard/resource/namespace"
+	"github.com/kubernetes/dashboard/resource/node"
+	"github.com/kubernetes/dashboard/resource/notification"
+	"github.com/kubernetes/dashboard/resource/persistentvolume"
+	"github.com/kubernetes/dashboard/resource/persistentvolumeclaim"
 	"github.com/kubernetes/dashboard/resource/pod"
 	"github.com/kubernetes/dashboard/resource/replicaset"
 	. "github.com/kubernetes/dashboard/resource/replicationcontroller"
@@ -34,8 +48,11 @@ import (
 	resourceService "github.com/kubernetes/dashboard/resource/service"
 	"github.com/kubernetes/dashboard/resource/workload"
 	. "github.com/kubernetes/dashboard/validation"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	"k8s.io/kubernetes/pkg/labels"
 )
 
 const (
@@ -44,14 +61,64 @@ const (
 
 	// ResponseLogString is a template for response log message.
 	ResponseLogString = "Outcoming response to %s with %d status code"
+
+	// Time after which terminal sessions that receive no input from the user are closed.
+	execSessionIdleTimeout = 15 * time.Minute
+)
+
+// Names of request attributes set by the authentication filter.
+const (
+	apiClientAttribute    = "apiClient"
+	clientConfigAttribute = "clientConfig"
 )
 
-// ApiHandler is a representation of API handler. Structure contains client, Heapster client and
-// client configuration.
+// ApiHandler is a representation of API handler. Structure contains client, Heapster client,
+// client configuration and mode of authenticating users to the Apiserver.
 type ApiHandler struct {
-	client         *client.Client
-	heapsterClient HeapsterClient
-	clientConfig   clientcmd.ClientConfig
+	client             *client.Client
+	heapsterClient     HeapsterClient
+	clientConfig       clientcmd.ClientConfig
+	authenticationMode AuthenticationMode
+}
+
+// Web-service filter function that decides whose credentials the request is handled with. In
+// shared authentication mode all requests use Dashboard UI client, in per-user mode a client
+// authenticating with the bearer token of the request is created and requests without the token
+// are rejected.
+func (apiHandler *ApiHandler) authenticate(request *restful.Request, response *restful.Response,
+	chain *restful.FilterChain) {
+
+	if apiHandler.authenticationMode != PerUserAuthenticationMode {
+		request.SetAttribute(apiClientAttribute, apiHandler.client)
+		request.SetAttribute(clientConfigAttribute, apiHandler.clientConfig)
+		chain.ProcessFilter(request, response)
+		return
+	}
+
+	token := GetBearerToken(request.Request)
+	if len(token) == 0 {
+		handleUnauthorizedError(response, ErrNoBearerToken)
+		return
+	}
+
+	apiClient, clientConfig, err := CreateUserClient(apiHandler.clientConfig, token)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	request.SetAttribute(apiClientAttribute, apiClient)
+	request.SetAttribute(clientConfigAttribute, clientConfig)
+	chain.ProcessFilter(request, response)
+}
+
+// Returns Apiserver client the request should be handled with, set by the authentication filter.
+func getApiClient(request *restful.Request) *client.Client {
+	return request.Attribute(apiClientAttribute).(*client.Client)
+}
+
+// Returns client config the request should be handled with, set by the authentication filter.
+func getClientConfig(request *restful.Request) clientcmd.ClientConfig {
+	return request.Attribute(clientConfigAttribute).(clientcmd.ClientConfig)
 }
 
 // Web-service filter function used for request and response logging.
@@ -81,13 +148,28 @@ func FormatResponseLog(resp *restful.Response, req *restful.Request) string {
 
 // CreateHttpApiHandler creates a new HTTP handler that handles all requests to the API of the backend.
 func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
-	clientConfig clientcmd.ClientConfig) http.Handler {
+	clientConfig clientcmd.ClientConfig, authenticationMode AuthenticationMode) http.Handler {
 
-	apiHandler := ApiHandler{client, heapsterClient, clientConfig}
+	apiHandler := ApiHandler{client, heapsterClient, clientConfig, authenticationMode}
 	wsContainer := restful.NewContainer()
 
+	loginWs := new(restful.WebService)
+	loginWs.Filter(wsLogger)
+	loginWs.Path("/api/v1/login").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	loginWs.Route(
+		loginWs.POST("").
+			To(apiHandler.handleLogin).
+			Reads(LoginSpec{}))
+	loginWs.Route(
+		loginWs.DELETE("").
+			To(apiHandler.handleLogout))
+	wsContainer.Add(loginWs)
+
 	deployWs := new(restful.WebService)
 	deployWs.Filter(wsLogger)
+	deployWs.Filter(apiHandler.authenticate)
 	deployWs.Path("/api/v1/appdeployments").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -95,7 +177,7 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		deployWs.POST("").
 			To(apiHandler.handleDeploy).
 			Reads(AppDeploymentSpec{}).
-			Writes(AppDeploymentSpec{}))
+			Writes(AppDeploymentReport{}))
 	deployWs.Route(
 		deployWs.POST("/validate/name").
 			To(apiHandler.handleNameValidity).
@@ -118,6 +200,8 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 	wsContainer.Add(deployWs)
 
 	deployFromFileWs := new(restful.WebService)
+	deployFromFileWs.Filter(wsLogger)
+	deployFromFileWs.Filter(apiHandler.authenticate)
 	deployFromFileWs.Path("/api/v1/appdeploymentfromfile").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -130,6 +214,7 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 
 	replicationControllerWs := new(restful.WebService)
 	replicationControllerWs.Filter(wsLogger)
+	replicationControllerWs.Filter(apiHandler.authenticate)
 	replicationControllerWs.Path("/api/v1/replicationcontrollers").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -137,6 +222,10 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		replicationControllerWs.GET("").
 			To(apiHandler.handleGetReplicationControllerList).
 			Writes(ReplicationControllerList{}))
+	replicationControllerWs.Route(
+		replicationControllerWs.GET("/{namespace}").
+			To(apiHandler.handleGetReplicationControllerList).
+			Writes(ReplicationControllerList{}))
 	replicationControllerWs.Route(
 		replicationControllerWs.GET("/{namespace}/{replicationController}").
 			To(apiHandler.handleGetReplicationControllerDetail).
@@ -156,6 +245,7 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 
 	workloadsWs := new(restful.WebService)
 	workloadsWs.Filter(wsLogger)
+	workloadsWs.Filter(apiHandler.authenticate)
 	workloadsWs.Path("/api/v1/workloads").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -163,10 +253,15 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		workloadsWs.GET("").
 			To(apiHandler.handleGetWorkloads).
 			Writes(workload.Workloads{}))
+	workloadsWs.Route(
+		workloadsWs.GET("/{namespace}").
+			To(apiHandler.handleGetWorkloads).
+			Writes(workload.Workloads{}))
 	wsContainer.Add(workloadsWs)
 
 	replicaSetsWs := new(restful.WebService)
 	replicaSetsWs.Filter(wsLogger)
+	replicaSetsWs.Filter(apiHandler.authenticate)
 	replicaSetsWs.Path("/api/v1/replicasets").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -174,10 +269,30 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		replicaSetsWs.GET("").
 			To(apiHandler.handleGetReplicaSets).
 			Writes(replicaset.ReplicaSetList{}))
+	replicaSetsWs.Route(
+		replicaSetsWs.GET("/{namespace}").
+			To(apiHandler.handleGetReplicaSets).
+			Writes(replicaset.ReplicaSetList{}))
+	replicaSetsWs.Route(
+		replicaSetsWs.GET("/{namespace}/{replicaSet}").
+			To(apiHandler.handleGetReplicaSetDetail).
+			Writes(replicaset.ReplicaSetDetail{}))
+	replicaSetsWs.Route(
+		replicaSetsWs.GET("/{namespace}/{replicaSet}/events").
+			To(apiHandler.handleGetObjectEvents("ReplicaSet", "replicaSet")).
+			Writes(Events{}))
+	replicaSetsWs.Route(
+		replicaSetsWs.POST("/{namespace}/{replicaSet}/update/pods").
+			To(apiHandler.handleUpdateReplicaSetReplicasCount).
+			Reads(replicaset.ReplicaSetSpec{}))
+	replicaSetsWs.Route(
+		replicaSetsWs.DELETE("/{namespace}/{replicaSet}").
+			To(apiHandler.handleDeleteReplicaSet))
 	wsContainer.Add(replicaSetsWs)
 
 	podsWs := new(restful.WebService)
 	podsWs.Filter(wsLogger)
+	podsWs.Filter(apiHandler.authenticate)
 	podsWs.Path("/api/v1/pods").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -185,10 +300,23 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		podsWs.GET("").
 			To(apiHandler.handleGetPods).
 			Writes(pod.PodList{}))
+	podsWs.Route(
+		podsWs.GET("/{namespace}").
+			To(apiHandler.handleGetPods).
+			Writes(pod.PodList{}))
+	podsWs.Route(
+		podsWs.GET("/{namespace}/{pod}").
+			To(apiHandler.handleGetPodDetail).
+			Writes(pod.PodDetail{}))
+	podsWs.Route(
+		podsWs.GET("/{namespace}/{pod}/events").
+			To(apiHandler.handleGetObjectEvents("Pod", "pod")).
+			Writes(Events{}))
 	wsContainer.Add(podsWs)
 
 	deploymentsWs := new(restful.WebService)
 	deploymentsWs.Filter(wsLogger)
+	deploymentsWs.Filter(apiHandler.authenticate)
 	deploymentsWs.Path("/api/v1/deployments").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -196,10 +324,141 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		deploymentsWs.GET("").
 			To(apiHandler.handleGetDeployments).
 			Writes(deployment.DeploymentList{}))
+	deploymentsWs.Route(
+		deploymentsWs.GET("/{namespace}").
+			To(apiHandler.handleGetDeployments).
+			Writes(deployment.DeploymentList{}))
+	deploymentsWs.Route(
+		deploymentsWs.GET("/{namespace}/{deployment}").
+			To(apiHandler.handleGetDeploymentDetail).
+			Writes(deployment.DeploymentDetail{}))
+	deploymentsWs.Route(
+		deploymentsWs.GET("/{namespace}/{deployment}/events").
+			To(apiHandler.handleGetObjectEvents("Deployment", "deployment")).
+			Writes(Events{}))
+	deploymentsWs.Route(
+		deploymentsWs.POST("/{namespace}/{deployment}/update/pods").
+			To(apiHandler.handleUpdateDeploymentReplicasCount).
+			Reads(deployment.DeploymentSpec{}))
+	deploymentsWs.Route(
+		deploymentsWs.DELETE("/{namespace}/{deployment}").
+			To(apiHandler.handleDeleteDeployment))
 	wsContainer.Add(deploymentsWs)
 
+	jobsWs := new(restful.WebService)
+	jobsWs.Filter(wsLogger)
+	jobsWs.Filter(apiHandler.authenticate)
+	jobsWs.Path("/api/v1/jobs").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	jobsWs.Route(
+		jobsWs.GET("").
+			To(apiHandler.handleGetJobs).
+			Writes(job.JobList{}))
+	jobsWs.Route(
+		jobsWs.GET("/{namespace}").
+			To(apiHandler.handleGetJobs).
+			Writes(job.JobList{}))
+	jobsWs.Route(
+		jobsWs.GET("/{namespace}/{job}").
+			To(apiHandler.handleGetJobDetail).
+			Writes(job.JobDetail{}))
+	jobsWs.Route(
+		jobsWs.GET("/{namespace}/{job}/events").
+			To(apiHandler.handleGetObjectEvents("Job", "job")).
+			Writes(Events{}))
+	jobsWs.Route(
+		jobsWs.DELETE("/{namespace}/{job}").
+			To(apiHandler.handleDeleteJob))
+	wsContainer.Add(jobsWs)
+
+	daemonSetsWs := new(restful.WebService)
+	daemonSetsWs.Filter(wsLogger)
+	daemonSetsWs.Filter(apiHandler.authenticate)
+	daemonSetsWs.Path("/api/v1/daemonsets").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	daemonSetsWs.Route(
+		daemonSetsWs.GET("").
+			To(apiHandler.handleGetDaemonSets).
+			Writes(daemonset.DaemonSetList{}))
+	daemonSetsWs.Route(
+		daemonSetsWs.GET("/{namespace}").
+			To(apiHandler.handleGetDaemonSets).
+			Writes(daemonset.DaemonSetList{}))
+	daemonSetsWs.Route(
+		daemonSetsWs.GET("/{namespace}/{daemonSet}").
+			To(apiHandler.handleGetDaemonSetDetail).
+			Writes(daemonset.DaemonSetDetail{}))
+	daemonSetsWs.Route(
+		daemonSetsWs.GET("/{namespace}/{daemonSet}/events").
+			To(apiHandler.handleGetObjectEvents("DaemonSet", "daemonSet")).
+			Writes(Events{}))
+	wsContainer.Add(daemonSetsWs)
+
+	ingressesWs := new(restful.WebService)
+	ingressesWs.Filter(wsLogger)
+	ingressesWs.Filter(apiHandler.authenticate)
+	ingressesWs.Path("/api/v1/ingresses").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ingressesWs.Route(
+		ingressesWs.GET("").
+			To(apiHandler.handleGetIngresses).
+			Writes(ingress.IngressList{}))
+	ingressesWs.Route(
+		ingressesWs.GET("/{namespace}").
+			To(apiHandler.handleGetIngresses).
+			Writes(ingress.IngressList{}))
+	ingressesWs.Route(
+		ingressesWs.GET("/{namespace}/{ingress}").
+			To(apiHandler.handleGetIngressDetail).
+			Writes(ingress.IngressDetail{}))
+	ingressesWs.Route(
+		ingressesWs.GET("/{namespace}/{ingress}/events").
+			To(apiHandler.handleGetObjectEvents("Ingress", "ingress")).
+			Writes(Events{}))
+	wsContainer.Add(ingressesWs)
+
+	persistentVolumesWs := new(restful.WebService)
+	persistentVolumesWs.Filter(wsLogger)
+	persistentVolumesWs.Filter(apiHandler.authenticate)
+	persistentVolumesWs.Path("/api/v1/persistentvolumes").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	persistentVolumesWs.Route(
+		persistentVolumesWs.GET("").
+			To(apiHandler.handleGetPersistentVolumes).
+			Writes(persistentvolume.PersistentVolumeList{}))
+	persistentVolumesWs.Route(
+		persistentVolumesWs.GET("/{persistentVolume}").
+			To(apiHandler.handleGetPersistentVolumeDetail).
+			Writes(persistentvolume.PersistentVolumeDetail{}))
+	wsContainer.Add(persistentVolumesWs)
+
+	persistentVolumeClaimsWs := new(restful.WebService)
+	persistentVolumeClaimsWs.Filter(wsLogger)
+	persistentVolumeClaimsWs.Filter(apiHandler.authenticate)
+	persistentVolumeClaimsWs.Path("/api/v1/persistentvolumeclaims").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	persistentVolumeClaimsWs.Route(
+		persistentVolumeClaimsWs.GET("").
+			To(apiHandler.handleGetPersistentVolumeClaims).
+			Writes(persistentvolumeclaim.PersistentVolumeClaimList{}))
+	persistentVolumeClaimsWs.Route(
+		persistentVolumeClaimsWs.GET("/{namespace}").
+			To(apiHandler.handleGetPersistentVolumeClaims).
+			Writes(persistentvolumeclaim.PersistentVolumeClaimList{}))
+	persistentVolumeClaimsWs.Route(
+		persistentVolumeClaimsWs.GET("/{namespace}/{persistentVolumeClaim}").
+			To(apiHandler.handleGetPersistentVolumeClaimDetail).
+			Writes(persistentvolumeclaim.PersistentVolumeClaimDetail{}))
+	wsContainer.Add(persistentVolumeClaimsWs)
+
 	namespacesWs := new(restful.WebService)
 	namespacesWs.Filter(wsLogger)
+	namespacesWs.Filter(apiHandler.authenticate)
 	namespacesWs.Path("/api/v1/namespaces").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -212,10 +471,18 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		namespacesWs.GET("").
 			To(apiHandler.handleGetNamespaces).
 			Writes(NamespaceList{}))
+	namespacesWs.Route(
+		namespacesWs.GET("/{name}").
+			To(apiHandler.handleGetNamespaceDetail).
+			Writes(NamespaceDetail{}))
+	namespacesWs.Route(
+		namespacesWs.DELETE("/{name}").
+			To(apiHandler.handleDeleteNamespace))
 	wsContainer.Add(namespacesWs)
 
 	logsWs := new(restful.WebService)
 	logsWs.Filter(wsLogger)
+	logsWs.Filter(apiHandler.authenticate)
 	logsWs.Path("/api/v1/logs").
 		Produces(restful.MIME_JSON)
 	logsWs.Route(
@@ -228,10 +495,43 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 			Writes(Logs{}))
 	wsContainer.Add(logsWs)
 
+	execWs := new(restful.WebService)
+	execWs.Filter(wsLogger)
+	execWs.Filter(apiHandler.authenticate)
+	execWs.Path("/api/v1/exec")
+	execWs.Route(
+		execWs.GET("/{namespace}/{podId}").
+			To(apiHandler.handleExec))
+	execWs.Route(
+		execWs.GET("/{namespace}/{podId}/{container}").
+			To(apiHandler.handleExec))
+	wsContainer.Add(execWs)
+
+	watchWs := new(restful.WebService)
+	watchWs.Filter(wsLogger)
+	watchWs.Filter(apiHandler.authenticate)
+	watchWs.Path("/api/v1/watch")
+	watchWs.Route(
+		watchWs.GET("/{resource}").
+			To(apiHandler.handleWatch))
+	watchWs.Route(
+		watchWs.GET("/{resource}/{namespace}").
+			To(apiHandler.handleWatch))
+	wsContainer.Add(watchWs)
+
 	eventsWs := new(restful.WebService)
 	eventsWs.Filter(wsLogger)
+	eventsWs.Filter(apiHandler.authenticate)
 	eventsWs.Path("/api/v1/events").
 		Produces(restful.MIME_JSON)
+	eventsWs.Route(
+		eventsWs.GET("").
+			To(apiHandler.handleGetEventList).
+			Writes(Events{}))
+	eventsWs.Route(
+		eventsWs.GET("/{namespace}").
+			To(apiHandler.handleGetEventList).
+			Writes(Events{}))
 	eventsWs.Route(
 		eventsWs.GET("/{namespace}/{replicationController}").
 			To(apiHandler.handleEvents).
@@ -239,7 +539,11 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 	wsContainer.Add(eventsWs)
 
 	secretsWs := new(restful.WebService)
-	secretsWs.Path("/api/v1/secrets").Produces(restful.MIME_JSON)
+	secretsWs.Filter(wsLogger)
+	secretsWs.Filter(apiHandler.authenticate)
+	secretsWs.Path("/api/v1/secrets").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
 	secretsWs.Route(
 		secretsWs.GET("/{namespace}").
 			To(apiHandler.handleGetSecrets).
@@ -249,10 +553,32 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 			To(apiHandler.handleCreateImagePullSecret).
 			Reads(ImagePullSecretSpec{}).
 			Writes(Secret{}))
+	secretsWs.Route(
+		secretsWs.POST("/{namespace}").
+			To(apiHandler.handleCreateSecret).
+			Reads(GenericSecretSpec{}).
+			Writes(Secret{}))
+	secretsWs.Route(
+		secretsWs.GET("/{namespace}/{secret}").
+			To(apiHandler.handleGetSecretDetail).
+			Writes(SecretDetail{}))
+	secretsWs.Route(
+		secretsWs.PUT("/{namespace}/{secret}").
+			To(apiHandler.handleUpdateSecret).
+			Reads(GenericSecretSpec{}).
+			Writes(Secret{}))
+	secretsWs.Route(
+		secretsWs.DELETE("/{namespace}/{secret}").
+			To(apiHandler.handleDeleteSecret))
+	secretsWs.Route(
+		secretsWs.GET("/{namespace}/{secret}/data/{key}").
+			To(apiHandler.handleGetSecretValue).
+			Writes(SecretValue{}))
 	wsContainer.Add(secretsWs)
 
 	servicesWs := new(restful.WebService)
 	servicesWs.Filter(wsLogger)
+	servicesWs.Filter(apiHandler.authenticate)
 	servicesWs.Path("/api/v1/services").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
@@ -260,65 +586,141 @@ func CreateHttpApiHandler(client *client.Client, heapsterClient HeapsterClient,
 		servicesWs.GET("").
 			To(apiHandler.handleGetServiceList).
 			Writes(resourceService.ServiceList{}))
+	servicesWs.Route(
+		servicesWs.GET("/{namespace}").
+			To(apiHandler.handleGetServiceList).
+			Writes(resourceService.ServiceList{}))
 	servicesWs.Route(
 		servicesWs.GET("/{namespace}/{service}").
 			To(apiHandler.handleGetServiceDetail).
 			Writes(resourceService.ServiceDetail{}))
+	servicesWs.Route(
+		servicesWs.GET("/{namespace}/{service}/events").
+			To(apiHandler.handleGetObjectEvents("Service", "service")).
+			Writes(Events{}))
 	wsContainer.Add(servicesWs)
 
+	nodesWs := new(restful.WebService)
+	nodesWs.Filter(wsLogger)
+	nodesWs.Filter(apiHandler.authenticate)
+	nodesWs.Path("/api/v1/nodes").
+		Produces(restful.MIME_JSON)
+	nodesWs.Route(
+		nodesWs.GET("").
+			To(apiHandler.handleGetNodeList).
+			Writes(node.NodeList{}))
+	nodesWs.Route(
+		nodesWs.GET("/{name}").
+			To(apiHandler.handleGetNodeDetail).
+			Writes(node.NodeDetail{}))
+	nodesWs.Route(
+		nodesWs.GET("/{name}/events").
+			To(apiHandler.handleGetObjectEvents("Node", "name")).
+			Writes(Events{}))
+	wsContainer.Add(nodesWs)
+
 	return wsContainer
 }
 
 // Handles get service list API call.
 func (apiHandler *ApiHandler) handleGetServiceList(request *restful.Request, response *restful.Response) {
-	result, err := resourceService.GetServiceList(apiHandler.client)
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := resourceService.GetServiceList(getApiClient(request), namespace, dsQuery)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
 // Handles get service detail API call.
 func (apiHandler *ApiHandler) handleGetServiceDetail(request *restful.Request, response *restful.Response) {
 	namespace := request.PathParameter("namespace")
 	service := request.PathParameter("service")
-	result, err := resourceService.GetServiceDetail(apiHandler.client, namespace, service)
+	result, err := resourceService.GetServiceDetail(getApiClient(request), apiHandler.heapsterClient,
+		namespace, service)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles get node list API call.
+func (apiHandler *ApiHandler) handleGetNodeList(request *restful.Request, response *restful.Response) {
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := node.GetNodeList(getApiClient(request), dsQuery)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles get node detail API call.
+func (apiHandler *ApiHandler) handleGetNodeDetail(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	result, err := node.GetNodeDetail(getApiClient(request), apiHandler.heapsterClient, name)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
 // Handles deploy API call.
 func (apiHandler *ApiHandler) handleDeploy(request *restful.Request, response *restful.Response) {
 	appDeploymentSpec := new(AppDeploymentSpec)
 	if err := request.ReadEntity(appDeploymentSpec); err != nil {
-		handleInternalError(response, err)
+		handleBadRequestError(response, err)
 		return
 	}
-	if err := DeployApp(appDeploymentSpec, apiHandler.client); err != nil {
-		handleInternalError(response, err)
+	report, err := DeployApp(appDeploymentSpec, getApiClient(request))
+	if err != nil {
+		log.Print(err)
+		response.WriteHeaderAndEntity(getErrorResponse(err).Code, report)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, appDeploymentSpec)
+	response.WriteHeaderAndEntity(http.StatusCreated, report)
 }
 
 // Handles deploy from file API call.
 func (apiHandler *ApiHandler) handleDeployFromFile(request *restful.Request, response *restful.Response) {
 	deploymentSpec := new(AppDeploymentFromFileSpec)
 	if err := request.ReadEntity(deploymentSpec); err != nil {
-		handleInternalError(response, err)
+		handleBadRequestError(response, err)
+		return
+	}
+	if mode := deploymentSpec.Mode; mode != "" && mode != CreateMode && mode != ApplyMode {
+		handleBadRequestError(response, fmt.Errorf("Unsupported mode of deployment from file: %s",
+			mode))
 		return
 	}
 
-	isDeployed, err := DeployAppFromFile(
-		deploymentSpec, CreateObjectFromInfoFn, apiHandler.clientConfig)
-	if !isDeployed {
-		handleInternalError(response, err)
+	results, err := DeployAppFromFile(
+		deploymentSpec, DeployObjectFromInfoFn, getClientConfig(request))
+
+	isDeployed := false
+	for _, result := range results {
+		if result.Status != ObjectFailed {
+			isDeployed = true
+		}
+	}
+	// Dry run reports all problems in the response, so that they can be shown per object.
+	if !isDeployed && !deploymentSpec.DryRun {
+		handleError(response, err)
 		return
 	}
 
@@ -327,10 +729,16 @@ func (apiHandler *ApiHandler) handleDeployFromFile(request *restful.Request, res
 		errorMessage = err.Error()
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, AppDeploymentFromFileResponse{
+	status := http.StatusCreated
+	if deploymentSpec.DryRun {
+		status = http.StatusOK
+	}
+
+	response.WriteHeaderAndEntity(status, AppDeploymentFromFileResponse{
 		Name:    deploymentSpec.Name,
 		Content: deploymentSpec.Content,
 		Error:   errorMessage,
+		Results: results,
 	})
 }
 
@@ -338,278 +746,1099 @@ func (apiHandler *ApiHandler) handleDeployFromFile(request *restful.Request, res
 func (apiHandler *ApiHandler) handleNameValidity(request *restful.Request, response *restful.Response) {
 	spec := new(AppNameValiditySpec)
 	if err := request.ReadEntity(spec); err != nil {
-		handleInternalError(response, err)
+		handleBadRequestError(response, err)
 		return
 	}
 
-	validity, err := ValidateAppName(spec, apiHandler.client)
+	validity, err := ValidateAppName(spec, getApiClient(request))
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, validity)
+	response.WriteHeaderAndEntity(http.StatusOK, validity)
 }
 
 // Handles image reference validation API call.
 func (ApiHandler *ApiHandler) handleImageReferenceValidity(request *restful.Request, response *restful.Response) {
 	spec := new(ImageReferenceValiditySpec)
 	if err := request.ReadEntity(spec); err != nil {
-		handleInternalError(response, err)
+		handleBadRequestError(response, err)
 		return
 	}
 
 	validity, err := ValidateImageReference(spec)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusCreated, validity)
+	response.WriteHeaderAndEntity(http.StatusOK, validity)
 }
 
 // Handles protocol validation API call.
 func (apiHandler *ApiHandler) handleProtocolValidity(request *restful.Request, response *restful.Response) {
 	spec := new(ProtocolValiditySpec)
 	if err := request.ReadEntity(spec); err != nil {
-		handleInternalError(response, err)
+		handleBadRequestError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, ValidateProtocol(spec))
+	response.WriteHeaderAndEntity(http.StatusOK, ValidateProtocol(spec))
 }
 
 // Handles get available protocols API call.
 func (apiHandler *ApiHandler) handleGetAvailableProcotols(request *restful.Request, response *restful.Response) {
-	response.WriteHeaderAndEntity(http.StatusCreated, GetAvailableProtocols())
+	response.WriteHeaderAndEntity(http.StatusOK, GetAvailableProtocols())
 }
 
 // Handles get Replication Controller list API call.
 func (apiHandler *ApiHandler) handleGetReplicationControllerList(
 	request *restful.Request, response *restful.Response) {
 
-	result, err := GetReplicationControllerList(apiHandler.client)
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
 	if err != nil {
-		handleInternalError(response, err)
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := GetReplicationControllerList(getApiClient(request), namespace, dsQuery)
+	if err != nil {
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
 // Handles get Workloads list API call.
 func (apiHandler *ApiHandler) handleGetWorkloads(
 	request *restful.Request, response *restful.Response) {
 
-	result, err := workload.GetWorkloads(apiHandler.client, apiHandler.heapsterClient)
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := workload.GetWorkloads(getApiClient(request), apiHandler.heapsterClient,
+		namespace, dsQuery)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
 // Handles get Replica Sets list API call.
 func (apiHandler *ApiHandler) handleGetReplicaSets(
 	request *restful.Request, response *restful.Response) {
 
-	result, err := replicaset.GetReplicaSetList(apiHandler.client)
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := replicaset.GetReplicaSetList(getApiClient(request), namespace, dsQuery)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles get Deployment list API call.
-func (apiHandler *ApiHandler) handleGetDeployments(
+// Handles get Replica Set detail API call.
+func (apiHandler *ApiHandler) handleGetReplicaSetDetail(
 	request *restful.Request, response *restful.Response) {
 
-	result, err := deployment.GetDeploymentList(apiHandler.client)
+	namespace := request.PathParameter("namespace")
+	replicaSet := request.PathParameter("replicaSet")
+	result, err := replicaset.GetReplicaSetDetail(getApiClient(request), apiHandler.heapsterClient,
+		namespace, replicaSet)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles get Pod list API call.
-func (apiHandler *ApiHandler) handleGetPods(
+// Handles update of Replica Set pods update API call.
+func (apiHandler *ApiHandler) handleUpdateReplicaSetReplicasCount(
 	request *restful.Request, response *restful.Response) {
 
-	result, err := pod.GetPodList(apiHandler.client, apiHandler.heapsterClient)
-	if err != nil {
-		handleInternalError(response, err)
+	namespace := request.PathParameter("namespace")
+	replicaSetName := request.PathParameter("replicaSet")
+	replicaSetSpec := new(replicaset.ReplicaSetSpec)
+
+	if err := request.ReadEntity(replicaSetSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+
+	if err := replicaset.UpdateReplicasCount(getApiClient(request), namespace, replicaSetName,
+		replicaSetSpec); err != nil {
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeader(http.StatusAccepted)
 }
 
-// Handles get Replication Controller detail API call.
-func (apiHandler *ApiHandler) handleGetReplicationControllerDetail(
+// Handles delete Replica Set API call.
+func (apiHandler *ApiHandler) handleDeleteReplicaSet(
 	request *restful.Request, response *restful.Response) {
 
 	namespace := request.PathParameter("namespace")
-	replicationController := request.PathParameter("replicationController")
-	result, err := GetReplicationControllerDetail(apiHandler.client, apiHandler.heapsterClient, namespace, replicationController)
+	replicaSet := request.PathParameter("replicaSet")
+	deleteServices, err := strconv.ParseBool(request.QueryParameter("deleteServices"))
 	if err != nil {
-		handleInternalError(response, err)
+		handleBadRequestError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
-}
+	if err := replicaset.DeleteReplicaSet(getApiClient(request), namespace,
+		replicaSet, deleteServices); err != nil {
+		handleError(response, err)
+		return
+	}
 
-// Handles update of Replication Controller pods update API call.
-func (apiHandler *ApiHandler) handleUpdateReplicasCount(
-	request *restful.Request, response *restful.Response) {
+	response.WriteHeader(http.StatusOK)
+}
 
+// Handles get Job list API call.
+func (apiHandler *ApiHandler) handleGetJobs(request *restful.Request, response *restful.Response) {
 	namespace := request.PathParameter("namespace")
-	replicationControllerName := request.PathParameter("replicationController")
-	replicationControllerSpec := new(ReplicationControllerSpec)
-
-	if err := request.ReadEntity(replicationControllerSpec); err != nil {
-		handleInternalError(response, err)
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
 		return
 	}
-
-	if err := UpdateReplicasCount(apiHandler.client, namespace, replicationControllerName,
-		replicationControllerSpec); err != nil {
-		handleInternalError(response, err)
+	result, err := job.GetJobList(getApiClient(request), namespace, dsQuery)
+	if err != nil {
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeader(http.StatusAccepted)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles delete Replication Controller API call.
-// TODO(floreks): there has to be some kind of transaction here
-func (apiHandler *ApiHandler) handleDeleteReplicationController(
-	request *restful.Request, response *restful.Response) {
+// Handles get Job detail API call.
+func (apiHandler *ApiHandler) handleGetJobDetail(request *restful.Request,
+	response *restful.Response) {
 
 	namespace := request.PathParameter("namespace")
-	replicationController := request.PathParameter("replicationController")
-	deleteServices, err := strconv.ParseBool(request.QueryParameter("deleteServices"))
+	name := request.PathParameter("job")
+	result, err := job.GetJobDetail(getApiClient(request), apiHandler.heapsterClient, namespace,
+		name)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	if err := DeleteReplicationController(apiHandler.client, namespace,
-		replicationController, deleteServices); err != nil {
-		handleInternalError(response, err)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles delete Job API call.
+func (apiHandler *ApiHandler) handleDeleteJob(request *restful.Request,
+	response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("job")
+	if err := job.DeleteJob(getApiClient(request), namespace, name); err != nil {
+		handleError(response, err)
 		return
 	}
 
 	response.WriteHeader(http.StatusOK)
 }
 
-// Handles get Replication Controller Pods API call.
-func (apiHandler *ApiHandler) handleGetReplicationControllerPods(
-	request *restful.Request, response *restful.Response) {
+// Handles get Daemon Set list API call.
+func (apiHandler *ApiHandler) handleGetDaemonSets(request *restful.Request,
+	response *restful.Response) {
 
 	namespace := request.PathParameter("namespace")
-	replicationController := request.PathParameter("replicationController")
-	limit, err := strconv.Atoi(request.QueryParameter("limit"))
+	dsQuery, err := getDataSelectQuery(request)
 	if err != nil {
-		limit = 0
+		handleBadRequestError(response, err)
+		return
 	}
-	result, err := GetReplicationControllerPods(apiHandler.client, namespace, replicationController, limit)
+	result, err := daemonset.GetDaemonSetList(getApiClient(request), namespace, dsQuery)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles namespace creation API call.
-func (apiHandler *ApiHandler) handleCreateNamespace(request *restful.Request,
+// Handles get Daemon Set detail API call.
+func (apiHandler *ApiHandler) handleGetDaemonSetDetail(request *restful.Request,
 	response *restful.Response) {
-	namespaceSpec := new(NamespaceSpec)
-	if err := request.ReadEntity(namespaceSpec); err != nil {
-		handleInternalError(response, err)
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("daemonSet")
+	result, err := daemonset.GetDaemonSetDetail(getApiClient(request), apiHandler.heapsterClient,
+		namespace, name)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles get Ingress list API call.
+func (apiHandler *ApiHandler) handleGetIngresses(request *restful.Request,
+	response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
 		return
 	}
-	if err := CreateNamespace(namespaceSpec, apiHandler.client); err != nil {
-		handleInternalError(response, err)
+	result, err := ingress.GetIngressList(getApiClient(request), namespace, dsQuery)
+	if err != nil {
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, namespaceSpec)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles get namespace list API call.
-func (apiHandler *ApiHandler) handleGetNamespaces(
-	request *restful.Request, response *restful.Response) {
+// Handles get Ingress detail API call.
+func (apiHandler *ApiHandler) handleGetIngressDetail(request *restful.Request,
+	response *restful.Response) {
 
-	result, err := GetNamespaceList(apiHandler.client)
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("ingress")
+	result, err := ingress.GetIngressDetail(getApiClient(request), namespace, name)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
 
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles image pull secret creation API call.
-func (apiHandler *ApiHandler) handleCreateImagePullSecret(request *restful.Request, response *restful.Response) {
-	secretSpec := new(ImagePullSecretSpec)
-	if err := request.ReadEntity(secretSpec); err != nil {
-		handleInternalError(response, err)
+// Handles get Persistent Volume list API call.
+func (apiHandler *ApiHandler) handleGetPersistentVolumes(request *restful.Request,
+	response *restful.Response) {
+
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
 		return
 	}
-	secret, err := CreateSecret(apiHandler.client, secretSpec)
+	result, err := persistentvolume.GetPersistentVolumeList(getApiClient(request), dsQuery)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusCreated, secret)
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles get secrets list API call.
-func (apiHandler *ApiHandler) handleGetSecrets(request *restful.Request, response *restful.Response) {
-	namespace := request.PathParameter("namespace")
-	result, err := GetSecrets(apiHandler.client, namespace)
+// Handles get Persistent Volume detail API call.
+func (apiHandler *ApiHandler) handleGetPersistentVolumeDetail(request *restful.Request,
+	response *restful.Response) {
+
+	name := request.PathParameter("persistentVolume")
+	result, err := persistentvolume.GetPersistentVolumeDetail(getApiClient(request), name)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
+
 	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles log API call.
-func (apiHandler *ApiHandler) handleLogs(request *restful.Request, response *restful.Response) {
-	namespace := request.PathParameter("namespace")
-	podId := request.PathParameter("podId")
-	container := request.PathParameter("container")
+// Handles get Persistent Volume Claim list API call.
+func (apiHandler *ApiHandler) handleGetPersistentVolumeClaims(request *restful.Request,
+	response *restful.Response) {
 
-	result, err := GetPodLogs(apiHandler.client, namespace, podId, container)
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimList(getApiClient(request),
+		namespace, dsQuery)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handles event API call.
-func (apiHandler *ApiHandler) handleEvents(request *restful.Request, response *restful.Response) {
+// Handles get Persistent Volume Claim detail API call.
+func (apiHandler *ApiHandler) handleGetPersistentVolumeClaimDetail(request *restful.Request,
+	response *restful.Response) {
+
 	namespace := request.PathParameter("namespace")
-	replicationController := request.PathParameter("replicationController")
-	result, err := GetEvents(apiHandler.client, namespace, replicationController)
+	name := request.PathParameter("persistentVolumeClaim")
+	result, err := persistentvolumeclaim.GetPersistentVolumeClaimDetail(getApiClient(request),
+		apiHandler.heapsterClient, namespace, name)
 	if err != nil {
-		handleInternalError(response, err)
+		handleError(response, err)
 		return
 	}
-	response.WriteHeaderAndEntity(http.StatusCreated, result)
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
 }
 
-// Handler that writes the given error to the response and sets appropriate HTTP status headers.
-func handleInternalError(response *restful.Response, err error) {
-	log.Print(err)
-	response.AddHeader("Content-Type", "text/plain")
-	response.WriteErrorString(http.StatusInternalServerError, err.Error()+"\n")
+// Handles get Deployment list API call.
+func (apiHandler *ApiHandler) handleGetDeployments(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := deployment.GetDeploymentList(getApiClient(request), namespace, dsQuery)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles get Deployment detail API call.
+func (apiHandler *ApiHandler) handleGetDeploymentDetail(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("deployment")
+	result, err := deployment.GetDeploymentDetail(getApiClient(request), apiHandler.heapsterClient,
+		namespace, name)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles update of Deployment pods update API call.
+func (apiHandler *ApiHandler) handleUpdateDeploymentReplicasCount(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	deploymentName := request.PathParameter("deployment")
+	deploymentSpec := new(deployment.DeploymentSpec)
+
+	if err := request.ReadEntity(deploymentSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+
+	if err := deployment.UpdateReplicasCount(getApiClient(request), namespace, deploymentName,
+		deploymentSpec); err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// Handles delete Deployment API call.
+func (apiHandler *ApiHandler) handleDeleteDeployment(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	deploymentName := request.PathParameter("deployment")
+	deleteServices, err := strconv.ParseBool(request.QueryParameter("deleteServices"))
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+
+	if err := deployment.DeleteDeployment(getApiClient(request), namespace,
+		deploymentName, deleteServices); err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+// Handles get Pod list API call.
+func (apiHandler *ApiHandler) handleGetPods(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	dsQuery, err := getDataSelectQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	result, err := pod.GetPodList(getApiClient(request), apiHandler.heapsterClient, namespace,
+		dsQuery)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles get Pod detail API call.
+func (apiHandler *ApiHandler) handleGetPodDetail(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	podName := request.PathParameter("pod")
+	result, err := pod.GetPodDetail(getApiClient(request), apiHandler.heapsterClient, namespace,
+		podName)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (apiHandler *ApiHandler) handleGetReplicationControllerDetail(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	replicationController := request.PathParameter("replicationController")
+	result, err := GetReplicationControllerDetail(getApiClient(request), apiHandler.heapsterClient, namespace, replicationController)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles update of Replication Controller pods update API call.
+func (apiHandler *ApiHandler) handleUpdateReplicasCount(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	replicationControllerName := request.PathParameter("replicationController")
+	replicationControllerSpec := new(ReplicationControllerSpec)
+
+	if err := request.ReadEntity(replicationControllerSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+
+	if err := UpdateReplicasCount(getApiClient(request), namespace, replicationControllerName,
+		replicationControllerSpec); err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// Handles delete Replication Controller API call.
+// TODO(floreks): there has to be some kind of transaction here
+func (apiHandler *ApiHandler) handleDeleteReplicationController(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	replicationController := request.PathParameter("replicationController")
+	deleteServices, err := strconv.ParseBool(request.QueryParameter("deleteServices"))
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+
+	if err := DeleteReplicationController(getApiClient(request), namespace,
+		replicationController, deleteServices); err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+// Handles get Replication Controller Pods API call.
+func (apiHandler *ApiHandler) handleGetReplicationControllerPods(
+	request *restful.Request, response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	replicationController := request.PathParameter("replicationController")
+	limit, err := strconv.Atoi(request.QueryParameter("limit"))
+	if err != nil {
+		limit = 0
+	}
+	result, err := GetReplicationControllerPods(getApiClient(request), namespace, replicationController, limit)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles namespace creation API call.
+func (apiHandler *ApiHandler) handleCreateNamespace(request *restful.Request,
+	response *restful.Response) {
+	namespaceSpec := new(NamespaceSpec)
+	if err := request.ReadEntity(namespaceSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	if err := CreateNamespace(namespaceSpec, getApiClient(request)); err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, namespaceSpec)
+}
+
+// Handles get namespace list API call.
+func (apiHandler *ApiHandler) handleGetNamespaces(
+	request *restful.Request, response *restful.Response) {
+
+	result, err := GetNamespaceList(getApiClient(request))
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles get namespace detail API call.
+func (apiHandler *ApiHandler) handleGetNamespaceDetail(request *restful.Request,
+	response *restful.Response) {
+
+	name := request.PathParameter("name")
+	result, err := GetNamespaceDetail(getApiClient(request), name)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles namespace deletion API call.
+func (apiHandler *ApiHandler) handleDeleteNamespace(request *restful.Request,
+	response *restful.Response) {
+
+	name := request.PathParameter("name")
+	if err := DeleteNamespace(getApiClient(request), name); err != nil {
+		handleError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+// Handles image pull secret creation API call.
+func (apiHandler *ApiHandler) handleCreateImagePullSecret(request *restful.Request, response *restful.Response) {
+	secretSpec := new(ImagePullSecretSpec)
+	if err := request.ReadEntity(secretSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	secret, err := CreateSecret(getApiClient(request), secretSpec)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, secret)
+}
+
+// Handles get secrets list API call.
+func (apiHandler *ApiHandler) handleGetSecrets(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	result, err := GetSecrets(getApiClient(request), namespace)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles Opaque, TLS or service account token secret creation API call.
+func (apiHandler *ApiHandler) handleCreateSecret(request *restful.Request, response *restful.Response) {
+	secretSpec := new(GenericSecretSpec)
+	if err := request.ReadEntity(secretSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	secretSpec.Namespace = request.PathParameter("namespace")
+	secret, err := CreateSecret(getApiClient(request), secretSpec)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusCreated, secret)
+}
+
+// Handles get secret detail API call.
+func (apiHandler *ApiHandler) handleGetSecretDetail(request *restful.Request,
+	response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("secret")
+	result, err := GetSecretDetail(getApiClient(request), namespace, name)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles secret update API call.
+func (apiHandler *ApiHandler) handleUpdateSecret(request *restful.Request, response *restful.Response) {
+	secretSpec := new(GenericSecretSpec)
+	if err := request.ReadEntity(secretSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	secretSpec.Namespace = request.PathParameter("namespace")
+	secretSpec.Name = request.PathParameter("secret")
+	secret, err := UpdateSecret(getApiClient(request), secretSpec.Namespace, secretSpec.Name,
+		secretSpec)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, secret)
+}
+
+// Handles secret deletion API call.
+func (apiHandler *ApiHandler) handleDeleteSecret(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("secret")
+	if err := DeleteSecret(getApiClient(request), namespace, name); err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+// Handles API call that reveals a single value of a secret.
+func (apiHandler *ApiHandler) handleGetSecretValue(request *restful.Request,
+	response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	name := request.PathParameter("secret")
+	key := request.PathParameter("key")
+	result, err := GetSecretValue(getApiClient(request), namespace, name, key)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles log API call. When follow query parameter is set, logs are streamed to the client as
+// server-sent events for as long as the container runs or the client stays connected.
+func (apiHandler *ApiHandler) handleLogs(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	podId := request.PathParameter("podId")
+
+	logOptions, err := getLogOptions(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+
+	if logOptions.Follow {
+		handleLogsStream(getApiClient(request), namespace, podId, logOptions, response)
+		return
+	}
+
+	result, err := GetPodLogs(getApiClient(request), namespace, podId, logOptions)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Writes logs of the given pod to the response line by line, each of them as a separate
+// server-sent event.
+func handleLogsStream(apiClient *client.Client, namespace, podId string,
+	logOptions *api.PodLogOptions, response *restful.Response) {
+
+	stream, err := StreamPodLogs(apiClient, namespace, podId, logOptions)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	defer stream.Close()
+
+	// Closing the stream unblocks reading from it once the client goes away.
+	if closeNotifier, ok := response.ResponseWriter.(http.CloseNotifier); ok {
+		closed := closeNotifier.CloseNotify()
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-closed:
+				stream.Close()
+			case <-done:
+			}
+		}()
+	}
+
+	response.AddHeader("Content-Type", "text/event-stream")
+	response.AddHeader("Cache-Control", "no-cache")
+	response.WriteHeader(http.StatusOK)
+	flusher, _ := response.ResponseWriter.(http.Flusher)
+
+	reader := bufio.NewReader(stream)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			fmt.Fprintf(response, "data: %s\n\n", strings.TrimSuffix(line, "\n"))
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("Stopped streaming logs of %s pod: %s", podId, err)
+			}
+			return
+		}
+	}
+}
+
+// Returns pod log options read from the container path parameter and tailLines, sinceSeconds,
+// sinceTime, limitBytes, previous and follow query parameters.
+func getLogOptions(request *restful.Request) (*api.PodLogOptions, error) {
+	logOptions := &api.PodLogOptions{
+		Container: request.PathParameter("container"),
+	}
+
+	var err error
+	if logOptions.TailLines, err = getInt64QueryParameter(request, "tailLines"); err != nil {
+		return nil, err
+	}
+	if logOptions.SinceSeconds, err = getInt64QueryParameter(request, "sinceSeconds"); err != nil {
+		return nil, err
+	}
+	if logOptions.LimitBytes, err = getInt64QueryParameter(request, "limitBytes"); err != nil {
+		return nil, err
+	}
+
+	if logOptions.SinceTime, err = getTimeQueryParameter(request, "sinceTime"); err != nil {
+		return nil, err
+	}
+	if logOptions.SinceSeconds != nil && logOptions.SinceTime != nil {
+		return nil, errors.New("Only one of sinceSeconds and sinceTime query parameters can be set")
+	}
+
+	if logOptions.Previous, err = getBoolQueryParameter(request, "previous"); err != nil {
+		return nil, err
+	}
+	if logOptions.Follow, err = getBoolQueryParameter(request, "follow"); err != nil {
+		return nil, err
+	}
+
+	return logOptions, nil
+}
+
+// Returns value of the given positive integer query parameter or nil when it is not set.
+func getInt64QueryParameter(request *restful.Request, name string) (*int64, error) {
+	value := request.QueryParameter(name)
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	result, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || result < 1 {
+		return nil, fmt.Errorf("Invalid %s query parameter: %s, positive integer expected",
+			name, value)
+	}
+	return &result, nil
+}
+
+// Returns value of the given RFC3339 time query parameter or nil when it is not set.
+func getTimeQueryParameter(request *restful.Request, name string) (*unversioned.Time, error) {
+	value := request.QueryParameter(name)
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	result, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid %s query parameter: %s", name, err)
+	}
+	return &unversioned.Time{Time: result}, nil
+}
+
+// Returns value of the given boolean query parameter or false when it is not set.
+func getBoolQueryParameter(request *restful.Request, name string) (bool, error) {
+	value := request.QueryParameter(name)
+	if len(value) == 0 {
+		return false, nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("Invalid %s query parameter: %s, boolean expected", name, value)
+	}
+	return result, nil
+}
+
+// Handles exec API call. Upgrades the connection to a WebSocket and bridges it to a command
+// executed in the container. Command and its arguments are read from command query parameters,
+// a shell is executed when none is given. Terminal resize is not supported, resize messages are
+// answered with an error message.
+func (apiHandler *ApiHandler) handleExec(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	podId := request.PathParameter("podId")
+
+	tty := true
+	if value := request.QueryParameter("tty"); len(value) > 0 {
+		var err error
+		if tty, err = strconv.ParseBool(value); err != nil {
+			handleBadRequestError(response, fmt.Errorf("Invalid tty query parameter: %s", value))
+			return
+		}
+	}
+	spec := ExecSpec{
+		Container: request.PathParameter("container"),
+		Command:   request.Request.URL.Query()["command"],
+		TTY:       tty,
+	}
+
+	apiClient := getApiClient(request)
+	config, err := getClientConfig(request).ClientConfig()
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+
+	server := websocket.Server{
+		Handshake: checkSameOrigin,
+		Handler: func(conn *websocket.Conn) {
+			session := NewTerminalSession(conn, execSessionIdleTimeout)
+			defer session.Close()
+
+			err := ExecInContainer(apiClient, config, namespace, podId, spec, session)
+			if err != nil {
+				log.Printf("Exec in %s pod failed: %s", podId, err)
+				session.Stderr().Write([]byte(err.Error()))
+			}
+		},
+	}
+	server.ServeHTTP(response.ResponseWriter, request.Request)
+}
+
+// WebSocket handshake function that rejects connections initiated by pages of other origins, so
+// that other sites cannot open terminals on behalf of the user.
+func checkSameOrigin(config *websocket.Config, request *http.Request) error {
+	origin, err := websocket.Origin(config, request)
+	if err != nil {
+		return err
+	}
+	if origin == nil || origin.Host != request.Host {
+		return fmt.Errorf("Cross origin WebSocket connection from %v rejected", origin)
+	}
+	return nil
+}
+
+// Handles watch API call. Streams notifications about changes of the resources in the namespace,
+// optionally restricted by the labelSelector query parameter, as server-sent events. Event ids
+// are resource versions, so that browsers that reconnect resume watching where they stopped.
+func (apiHandler *ApiHandler) handleWatch(request *restful.Request, response *restful.Response) {
+	spec := notification.WatchSpec{
+		Resource:        request.PathParameter("resource"),
+		Namespace:       request.PathParameter("namespace"),
+		LabelSelector:   labels.Everything(),
+		ResourceVersion: request.HeaderParameter("Last-Event-ID"),
+	}
+	if !notification.CanWatch(spec.Resource) {
+		handleBadRequestError(response,
+			fmt.Errorf("Invalid resource: %s, it cannot be watched", spec.Resource))
+		return
+	}
+	if selector := request.QueryParameter("labelSelector"); len(selector) > 0 {
+		var err error
+		if spec.LabelSelector, err = labels.Parse(selector); err != nil {
+			handleBadRequestError(response,
+				fmt.Errorf("Invalid labelSelector query parameter: %s", err))
+			return
+		}
+	}
+
+	watcher, err := notification.Watch(getApiClient(request), spec)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	defer watcher.Stop()
+
+	var closed <-chan bool
+	if closeNotifier, ok := response.ResponseWriter.(http.CloseNotifier); ok {
+		closed = closeNotifier.CloseNotify()
+	}
+
+	response.AddHeader("Content-Type", "text/event-stream")
+	response.AddHeader("Cache-Control", "no-cache")
+	response.WriteHeader(http.StatusOK)
+	flusher, _ := response.ResponseWriter.(http.Flusher)
+
+	for {
+		select {
+		case <-closed:
+			return
+		case change, ok := <-watcher.Notifications():
+			if !ok {
+				if err := watcher.Err(); err != nil {
+					// Status code is already sent, so the error is reported as a separate event.
+					data, _ := json.Marshal(getErrorResponse(err))
+					fmt.Fprintf(response, "event: watchError\ndata: %s\n\n", data)
+				}
+				return
+			}
+
+			data, err := json.Marshal(change)
+			if err != nil {
+				log.Printf("Stopped watching %s: %s", spec.Resource, err)
+				return
+			}
+			fmt.Fprintf(response, "id: %s\ndata: %s\n\n", change.ResourceVersion, data)
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// Handles get event list API call.
+func (apiHandler *ApiHandler) handleGetEventList(request *restful.Request,
+	response *restful.Response) {
+
+	namespace := request.PathParameter("namespace")
+	query, err := getEventQuery(request)
+	if err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	query.ObjectKind = request.QueryParameter("kind")
+	query.ObjectName = request.QueryParameter("name")
+
+	result, err := GetEventList(getApiClient(request), namespace, *query)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Returns handler of get events API call for the object of the given kind, whose name is read
+// from the given path parameter. Objects that are not namespaced have their events listed from
+// all namespaces.
+func (apiHandler *ApiHandler) handleGetObjectEvents(kind,
+	nameParameter string) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		namespace := request.PathParameter("namespace")
+		query, err := getEventQuery(request)
+		if err != nil {
+			handleBadRequestError(response, err)
+			return
+		}
+		query.ObjectKind = kind
+		query.ObjectName = request.PathParameter(nameParameter)
+
+		result, err := GetEventList(getApiClient(request), namespace, *query)
+		if err != nil {
+			handleError(response, err)
+			return
+		}
+		response.WriteHeaderAndEntity(http.StatusOK, result)
+	}
+}
+
+// Returns event query read from the type, reason, sinceTime and untilTime query parameters.
+func getEventQuery(request *restful.Request) (*EventQuery, error) {
+	query := &EventQuery{
+		Type:   request.QueryParameter("type"),
+		Reason: request.QueryParameter("reason"),
+	}
+	if len(query.Type) > 0 && query.Type != api.EventTypeNormal &&
+		query.Type != api.EventTypeWarning {
+		return nil, fmt.Errorf("Invalid type query parameter: %s, %s or %s expected", query.Type,
+			api.EventTypeNormal, api.EventTypeWarning)
+	}
+
+	var err error
+	if query.Since, err = getTimeQueryParameter(request, "sinceTime"); err != nil {
+		return nil, err
+	}
+	if query.Until, err = getTimeQueryParameter(request, "untilTime"); err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+// Handles event API call.
+func (apiHandler *ApiHandler) handleEvents(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	replicationController := request.PathParameter("replicationController")
+	result, err := GetEvents(getApiClient(request), namespace, replicationController)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+// Handles login API call. Verifies the bearer token with the Apiserver and stores it in a session
+// cookie, so that following requests are made with it.
+func (apiHandler *ApiHandler) handleLogin(request *restful.Request, response *restful.Response) {
+	loginSpec := new(LoginSpec)
+	if err := request.ReadEntity(loginSpec); err != nil {
+		handleBadRequestError(response, err)
+		return
+	}
+	if len(loginSpec.Token) == 0 {
+		handleUnauthorizedError(response, ErrNoBearerToken)
+		return
+	}
+
+	apiClient, _, err := CreateUserClient(apiHandler.clientConfig, loginSpec.Token)
+	if err != nil {
+		handleError(response, err)
+		return
+	}
+	if _, err := apiClient.Discovery().ServerGroups(); err != nil {
+		handleError(response, err)
+		return
+	}
+
+	http.SetCookie(response.ResponseWriter, &http.Cookie{
+		Name:     TokenCookieName,
+		Value:    loginSpec.Token,
+		Path:     "/",
+		Secure:   request.Request.TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+	response.WriteHeader(http.StatusOK)
+}
+
+// Handles logout API call. Removes the session cookie.
+func (apiHandler *ApiHandler) handleLogout(request *restful.Request, response *restful.Response) {
+	http.SetCookie(response.ResponseWriter, &http.Cookie{
+		Name:     TokenCookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+	response.WriteHeader(http.StatusOK)
 }
@@ -0,0 +1,146 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// Properties that lists can be sorted by with the sortBy query parameter.
+var sortableProperties = []common.PropertyName{
+	common.NameProperty,
+	common.CreationTimestampProperty,
+	common.StatusProperty,
+	common.RestartsProperty,
+}
+
+// Returns data select query read from itemsPerPage, page, sortBy and filterBy query parameters of
+// the request. The query selects whole list in its original order when none of them is set.
+func getDataSelectQuery(request *restful.Request) (*common.DataSelectQuery, error) {
+	paginationQuery, err := getPaginationQuery(request)
+	if err != nil {
+		return nil, err
+	}
+
+	sortQuery, err := getSortQuery(request.QueryParameter("sortBy"))
+	if err != nil {
+		return nil, err
+	}
+
+	filterQuery, err := getFilterQuery(request.Request.URL.Query()["filterBy"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.DataSelectQuery{
+		PaginationQuery: paginationQuery,
+		SortQuery:       sortQuery,
+		FilterQuery:     filterQuery,
+	}, nil
+}
+
+// Returns pagination query read from the itemsPerPage and page query parameters. Page defaults to
+// the first one.
+func getPaginationQuery(request *restful.Request) (*common.PaginationQuery, error) {
+	itemsPerPage, err := getInt64QueryParameter(request, "itemsPerPage")
+	if err != nil {
+		return nil, err
+	}
+	if itemsPerPage == nil {
+		return common.NoPagination, nil
+	}
+
+	page, err := getInt64QueryParameter(request, "page")
+	if err != nil {
+		return nil, err
+	}
+
+	query := &common.PaginationQuery{ItemsPerPage: int(*itemsPerPage), Page: 1}
+	if page != nil {
+		query.Page = int(*page)
+	}
+	return query, nil
+}
+
+// Returns sort query read from the sortBy query parameter, e.g., "status,-creationTimestamp". It
+// is a comma separated list of properties, each of them prefixed with "-" when the list should be
+// sorted by it in descending order.
+func getSortQuery(sortBy string) (*common.SortQuery, error) {
+	if len(sortBy) == 0 {
+		return common.NoSort, nil
+	}
+
+	query := &common.SortQuery{SortByList: make([]common.SortBy, 0)}
+	for _, property := range strings.Split(sortBy, ",") {
+		ascending := !strings.HasPrefix(property, "-")
+		name := common.PropertyName(strings.TrimPrefix(property, "-"))
+		if !isSortableProperty(name) {
+			return nil, fmt.Errorf("Invalid sortBy query parameter: %s, unknown property %s",
+				sortBy, strconv.Quote(string(name)))
+		}
+		query.SortByList = append(query.SortByList, common.SortBy{
+			Property:  name,
+			Ascending: ascending,
+		})
+	}
+	return query, nil
+}
+
+func isSortableProperty(name common.PropertyName) bool {
+	for _, property := range sortableProperties {
+		if property == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns filter query read from the filterBy query parameters. Each of them is either
+// "name:<substring>" or "labelSelector:<selector>", e.g., "labelSelector:app=nginx,tier!=db".
+func getFilterQuery(filterBy []string) (*common.FilterQuery, error) {
+	if len(filterBy) == 0 {
+		return common.NoFilter, nil
+	}
+
+	query := &common.FilterQuery{LabelSelector: labels.Everything()}
+	for _, filter := range filterBy {
+		parts := strings.SplitN(filter, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Invalid filterBy query parameter: %s, "+
+				"name:<substring> or labelSelector:<selector> expected", filter)
+		}
+
+		switch parts[0] {
+		case "name":
+			query.Name = parts[1]
+		case "labelSelector":
+			selector, err := labels.Parse(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid filterBy query parameter: %s, %s", filter, err)
+			}
+			query.LabelSelector = selector
+		default:
+			return nil, fmt.Errorf("Invalid filterBy query parameter: %s, unknown filter %s",
+				filter, strconv.Quote(parts[0]))
+		}
+	}
+	return query, nil
+}
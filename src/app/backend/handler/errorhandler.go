@@ -0,0 +1,109 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+
+	restful "github.com/emicklei/go-restful"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// ErrorResponse is a body of responses to failed API calls.
+type ErrorResponse struct {
+	// HTTP status code of the response.
+	Code int `json:"code"`
+
+	// Machine readable description of why the call failed, e.g., NotFound or Forbidden. See
+	// Kubernetes API for reference.
+	Reason unversioned.StatusReason `json:"reason"`
+
+	// Human readable description of the failure.
+	Message string `json:"message"`
+
+	// Additional data about the failure, e.g., kind and name of the object that was not found.
+	// Nil when there is none.
+	Details *unversioned.StatusDetails `json:"details,omitempty"`
+}
+
+// Handler that writes the given error to the response and sets appropriate HTTP status headers.
+// Errors returned by the Apiserver keep their status code, e.g., 404 for objects that do not exist,
+// and errors of connecting to the Apiserver are reported as 503.
+func handleError(response *restful.Response, err error) {
+	writeErrorResponse(response, getErrorResponse(err))
+}
+
+// Handler that writes the given error of a malformed request to the response and sets bad request
+// HTTP status header.
+func handleBadRequestError(response *restful.Response, err error) {
+	writeErrorResponse(response, ErrorResponse{
+		Code:    http.StatusBadRequest,
+		Reason:  unversioned.StatusReasonBadRequest,
+		Message: err.Error(),
+	})
+}
+
+// Handler that writes the given authentication error to the response and sets unauthorized HTTP
+// status header.
+func handleUnauthorizedError(response *restful.Response, err error) {
+	writeErrorResponse(response, ErrorResponse{
+		Code:    http.StatusUnauthorized,
+		Reason:  unversioned.StatusReasonUnauthorized,
+		Message: err.Error(),
+	})
+}
+
+// Writes the given error response as JSON with its status code.
+func writeErrorResponse(response *restful.Response, errorResponse ErrorResponse) {
+	log.Print(errorResponse.Message)
+	response.WriteHeaderAndJson(errorResponse.Code, errorResponse, restful.MIME_JSON)
+}
+
+// Returns response describing the given error.
+func getErrorResponse(err error) ErrorResponse {
+	if statusError, ok := err.(k8serrors.APIStatus); ok {
+		status := statusError.Status()
+		errorResponse := ErrorResponse{
+			Code:    int(status.Code),
+			Reason:  status.Reason,
+			Message: status.Message,
+			Details: status.Details,
+		}
+		if errorResponse.Code == 0 {
+			errorResponse.Code = http.StatusInternalServerError
+		}
+		if len(errorResponse.Message) == 0 {
+			errorResponse.Message = err.Error()
+		}
+		return errorResponse
+	}
+
+	if _, ok := err.(*url.Error); ok {
+		return ErrorResponse{
+			Code:    http.StatusServiceUnavailable,
+			Reason:  unversioned.StatusReasonServiceUnavailable,
+			Message: err.Error(),
+		}
+	}
+
+	return ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Reason:  unversioned.StatusReasonInternalError,
+		Message: err.Error(),
+	}
+}
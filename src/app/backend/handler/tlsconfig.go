@@ -0,0 +1,138 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"os"
+	"time"
+)
+
+// Validity period of generated self-signed certificates.
+const selfSignedCertificateValidity = 365 * 24 * time.Hour
+
+// TLSConfigSpec is a specification of how the dashboard serves HTTPS.
+type TLSConfigSpec struct {
+	// Paths of PEM encoded certificate and its private key to serve with.
+	CertFile string
+	KeyFile  string
+
+	// Whether to serve with a generated self-signed certificate when no certificate is given.
+	AutoGenerateCertificates bool
+
+	// Path of PEM encoded certificate authorities. When set, clients are required to present
+	// certificates signed by one of them.
+	ClientCAFile string
+}
+
+// CreateTLSConfig creates TLS config for serving HTTPS according to the given spec. Nil is returned
+// when the spec neither gives a certificate nor allows to generate one, i.e., HTTPS is disabled.
+func CreateTLSConfig(spec TLSConfigSpec) (*tls.Config, error) {
+	if (len(spec.CertFile) == 0) != (len(spec.KeyFile) == 0) {
+		return nil, errors.New("Both certificate and key file have to be given to serve HTTPS")
+	}
+
+	var certificate tls.Certificate
+	var err error
+	switch {
+	case len(spec.CertFile) > 0:
+		certificate, err = tls.LoadX509KeyPair(spec.CertFile, spec.KeyFile)
+	case spec.AutoGenerateCertificates:
+		log.Print("Generating self-signed certificate")
+		certificate, err = GenerateSelfSignedCertificate()
+	default:
+		if len(spec.ClientCAFile) > 0 {
+			return nil, errors.New("Client certificate authentication requires HTTPS, " +
+				"give a certificate or allow to generate one")
+		}
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if len(spec.ClientCAFile) > 0 {
+		pemCerts, err := ioutil.ReadFile(spec.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, fmt.Errorf("No certificates found in %s", spec.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// GenerateSelfSignedCertificate generates a self-signed certificate for the host the dashboard
+// runs on. The certificate is kept in memory only, so a new one is generated on every start.
+func GenerateSelfSignedCertificate() (tls.Certificate, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	notBefore := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: hostname,
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(selfSignedCertificateValidity),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{hostname, "localhost"},
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, &template,
+		&privateKey.PublicKey, privateKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{derBytes},
+		PrivateKey:  privateKey,
+	}, nil
+}
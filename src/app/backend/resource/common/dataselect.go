@@ -0,0 +1,231 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// PropertyName is a name of a list item property that lists can be sorted by.
+type PropertyName string
+
+// List of properties that lists can be sorted by.
+const (
+	NameProperty              PropertyName = "name"
+	CreationTimestampProperty PropertyName = "creationTimestamp"
+	StatusProperty            PropertyName = "status"
+	RestartsProperty          PropertyName = "restarts"
+)
+
+// ListMeta describes list of objects, i.e. holds information about pagination options set for the
+// list.
+type ListMeta struct {
+	// Total number of items on the list after filtering, regardless of pagination.
+	TotalItems int `json:"totalItems"`
+}
+
+// DataSelectQuery describes how to select the items of a list that are returned to the client.
+// The list is filtered first, then sorted and finally paginated.
+type DataSelectQuery struct {
+	PaginationQuery *PaginationQuery
+	SortQuery       *SortQuery
+	FilterQuery     *FilterQuery
+}
+
+// PaginationQuery selects a single page of a list.
+type PaginationQuery struct {
+	// Number of items on a page. No pagination is done when it is 0.
+	ItemsPerPage int
+
+	// Number of the page to return, starting from 1.
+	Page int
+}
+
+// SortQuery sorts a list by a sequence of properties. Items that are equal on a property are
+// compared on the next one.
+type SortQuery struct {
+	SortByList []SortBy
+}
+
+// SortBy sorts a list by a single property.
+type SortBy struct {
+	Property  PropertyName
+	Ascending bool
+}
+
+// FilterQuery keeps only list items that match all of its conditions.
+type FilterQuery struct {
+	// Substring that item names have to contain, case insensitive. Empty string matches all items.
+	Name string
+
+	// Label selector that item labels have to match.
+	LabelSelector labels.Selector
+}
+
+// NoPagination returns all items of a list on a single page.
+var NoPagination = &PaginationQuery{}
+
+// NoSort keeps original order of a list.
+var NoSort = &SortQuery{SortByList: []SortBy{}}
+
+// NoFilter keeps all items of a list.
+var NoFilter = &FilterQuery{LabelSelector: labels.Everything()}
+
+// NoDataSelect returns whole list in its original order.
+var NoDataSelect = &DataSelectQuery{
+	PaginationQuery: NoPagination,
+	SortQuery:       NoSort,
+	FilterQuery:     NoFilter,
+}
+
+// ComparableValue is a value of a list item property.
+type ComparableValue interface {
+	// Compare returns a negative number, zero or a positive number when the value is less than,
+	// equal to or greater than the other value of the same type.
+	Compare(other ComparableValue) int
+}
+
+// StdComparableString is a string property value.
+type StdComparableString string
+
+// Compare compares strings lexicographically.
+func (s StdComparableString) Compare(other ComparableValue) int {
+	return strings.Compare(string(s), string(other.(StdComparableString)))
+}
+
+// StdComparableInt is an integer property value.
+type StdComparableInt int
+
+// Compare compares integers.
+func (i StdComparableInt) Compare(other ComparableValue) int {
+	return int(i) - int(other.(StdComparableInt))
+}
+
+// StdComparableTime is a time property value.
+type StdComparableTime unversioned.Time
+
+// Compare compares times chronologically.
+func (t StdComparableTime) Compare(other ComparableValue) int {
+	otherTime := other.(StdComparableTime)
+	if t.Time.Before(otherTime.Time) {
+		return -1
+	}
+	if t.Time.After(otherTime.Time) {
+		return 1
+	}
+	return 0
+}
+
+// DataCell is a single item of a list that data select queries are applied to.
+type DataCell interface {
+	// GetObjectMeta returns metadata of the item. It is used to filter items and to sort them by
+	// name and creation timestamp.
+	GetObjectMeta() api.ObjectMeta
+
+	// GetProperty returns value of the given resource-specific property, such as status, or nil
+	// when the item does not have it.
+	GetProperty(name PropertyName) ComparableValue
+}
+
+// SelectData filters, sorts and paginates the given list items according to the query. Returns
+// selected items and list metadata with the number of items that matched the filter.
+func SelectData(cells []DataCell, query *DataSelectQuery) ([]DataCell, ListMeta) {
+	filtered := filterCells(cells, query.FilterQuery)
+	sorted := dataCells{cells: filtered, sortQuery: query.SortQuery}
+	sort.Stable(sorted)
+	return paginateCells(filtered, query.PaginationQuery), ListMeta{TotalItems: len(filtered)}
+}
+
+func filterCells(cells []DataCell, query *FilterQuery) []DataCell {
+	name := strings.ToLower(query.Name)
+	result := make([]DataCell, 0)
+	for _, cell := range cells {
+		meta := cell.GetObjectMeta()
+		if strings.Contains(strings.ToLower(meta.Name), name) &&
+			query.LabelSelector.Matches(labels.Set(meta.Labels)) {
+			result = append(result, cell)
+		}
+	}
+	return result
+}
+
+func paginateCells(cells []DataCell, query *PaginationQuery) []DataCell {
+	if query.ItemsPerPage == 0 {
+		return cells
+	}
+
+	start := (query.Page - 1) * query.ItemsPerPage
+	if start < 0 || start >= len(cells) {
+		return make([]DataCell, 0)
+	}
+	end := start + query.ItemsPerPage
+	if end > len(cells) {
+		end = len(cells)
+	}
+	return cells[start:end]
+}
+
+// Returns value of the given property of the list item. Properties that are common for all
+// resources are read from the item metadata.
+func getProperty(cell DataCell, name PropertyName) ComparableValue {
+	switch name {
+	case NameProperty:
+		return StdComparableString(cell.GetObjectMeta().Name)
+	case CreationTimestampProperty:
+		return StdComparableTime(cell.GetObjectMeta().CreationTimestamp)
+	default:
+		return cell.GetProperty(name)
+	}
+}
+
+// Implements sort.Interface for list items ordered by a sort query.
+type dataCells struct {
+	cells     []DataCell
+	sortQuery *SortQuery
+}
+
+func (d dataCells) Len() int {
+	return len(d.cells)
+}
+
+func (d dataCells) Swap(i, j int) {
+	d.cells[i], d.cells[j] = d.cells[j], d.cells[i]
+}
+
+func (d dataCells) Less(i, j int) bool {
+	for _, sortBy := range d.sortQuery.SortByList {
+		a := getProperty(d.cells[i], sortBy.Property)
+		b := getProperty(d.cells[j], sortBy.Property)
+		// Items without the property are placed after the ones that have it.
+		if a == nil || b == nil {
+			if a == nil && b == nil {
+				continue
+			}
+			return b == nil
+		}
+
+		cmp := a.Compare(b)
+		if cmp == 0 {
+			continue
+		}
+		return (cmp < 0) == sortBy.Ascending
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// GetExternalEndpoints returns array of external endpoints of the given service, where pods are
+// the ones of a resource that the service targets. Node port services are reachable through the
+// external IPs of nodes that run the pods. Services of all types are reachable through external
+// IPs set in their spec.
+func GetExternalEndpoints(pods []api.Pod, service api.Service, nodes []api.Node) []Endpoint {
+	var externalEndpoints []Endpoint
+	for _, externalIP := range service.Spec.ExternalIPs {
+		externalEndpoints = append(externalEndpoints, Endpoint{
+			Host:  externalIP,
+			Ports: GetServicePorts(service.Spec.Ports),
+		})
+	}
+
+	if service.Spec.Type == api.ServiceTypeNodePort {
+		externalEndpoints = append(externalEndpoints, getNodePortEndpoints(pods, service, nodes)...)
+	} else if service.Spec.Type == api.ServiceTypeLoadBalancer {
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			externalEndpoints = append(externalEndpoints, getExternalEndpoint(ingress,
+				service.Spec.Ports))
+		}
+
+		if len(service.Status.LoadBalancer.Ingress) == 0 {
+			externalEndpoints = append(externalEndpoints,
+				getNodePortEndpoints(pods, service, nodes)...)
+		}
+	}
+
+	if len(externalEndpoints) == 0 && (service.Spec.Type == api.ServiceTypeNodePort ||
+		service.Spec.Type == api.ServiceTypeLoadBalancer) {
+		externalEndpoints = getLocalhostEndpoints(service)
+	}
+
+	return externalEndpoints
+}
+
+// Returns localhost endpoints for specified node port or load balancer service.
+func getLocalhostEndpoints(service api.Service) []Endpoint {
+	var externalEndpoints []Endpoint
+	for _, port := range service.Spec.Ports {
+		externalEndpoints = append(externalEndpoints, Endpoint{
+			Host: "localhost",
+			Ports: []ServicePort{
+				{
+					Protocol: port.Protocol,
+					Port:     port.NodePort,
+				},
+			},
+		})
+	}
+	return externalEndpoints
+}
+
+// getNodeByName returns the node with the given name from the list
+func getNodeByName(nodes []api.Node, nodeName string) *api.Node {
+	for _, node := range nodes {
+		if node.ObjectMeta.Name == nodeName {
+			return &node
+		}
+	}
+	return nil
+}
+
+// Returns array of external endpoints for specified pods.
+func getNodePortEndpoints(pods []api.Pod, service api.Service, nodes []api.Node) []Endpoint {
+	var externalEndpoints []Endpoint
+	var externalIPs []string
+	for _, pod := range pods {
+		node := getNodeByName(nodes, pod.Spec.NodeName)
+		if node == nil {
+			continue
+		}
+		for _, adress := range node.Status.Addresses {
+			if adress.Type == api.NodeExternalIP && len(adress.Address) > 0 &&
+				isExternalIPUniqe(externalIPs, adress.Address) {
+				externalIPs = append(externalIPs, adress.Address)
+				for _, port := range service.Spec.Ports {
+					externalEndpoints = append(externalEndpoints, Endpoint{
+						Host: adress.Address,
+						Ports: []ServicePort{
+							{
+								Protocol: port.Protocol,
+								Port:     port.NodePort,
+							},
+						},
+					})
+				}
+			}
+		}
+	}
+	return externalEndpoints
+}
+
+// Returns true if given external IP is not part of given array.
+func isExternalIPUniqe(externalIPs []string, externalIP string) bool {
+	for _, h := range externalIPs {
+		if h == externalIP {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns external endpoint name for the given service properties.
+func getExternalEndpoint(ingress api.LoadBalancerIngress, ports []api.ServicePort) Endpoint {
+	var host string
+	if ingress.Hostname != "" {
+		host = ingress.Hostname
+	} else {
+		host = ingress.IP
+	}
+	return Endpoint{
+		Host:  host,
+		Ports: GetServicePorts(ports),
+	}
+}
@@ -95,8 +95,16 @@ func GetMatchingPods(labelSelector *unversioned.LabelSelector, namespace string,
 			selector.Matches(labels.Set(pod.ObjectMeta.Labels)) {
 			matchingPods = append(matchingPods, pod)
 		}
-		return matchingPods
 	}
 
 	return matchingPods
 }
+
+// GetContainerImages returns container image strings from the given pod spec.
+func GetContainerImages(podTemplate *api.PodSpec) []string {
+	var containerImages []string
+	for _, container := range podTemplate.Containers {
+		containerImages = append(containerImages, container.Image)
+	}
+	return containerImages
+}
@@ -0,0 +1,344 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"log"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/cache"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// ResourceCache is a shared, watch-driven in-memory cache of resource lists. Each resource kind
+// is kept in its own store that is filled by a reflector, which lists the resource once and then
+// keeps the store up to date by watching it.
+//
+// Resource list channels are fed from the cache when it is enabled with UseResourceCache and the
+// store of the requested kind has synced. Otherwise they fall back to listing resources directly
+// from the apiserver.
+type ResourceCache struct {
+	replicationControllers *cachedResource
+	replicaSets            *cachedResource
+	deployments            *cachedResource
+	services               *cachedResource
+	pods                   *cachedResource
+	events                 *cachedResource
+	nodes                  *cachedResource
+}
+
+// cachedResource is a store of a single resource kind together with the reflector that fills it.
+type cachedResource struct {
+	cache.Store
+
+	reflector *cache.Reflector
+
+	// Set to 1 once the reflector has replaced the store contents with the initial list.
+	synced int32
+}
+
+// How often Run checks whether all resource kinds have synced, so that it can be logged.
+const syncCheckPeriod = time.Second
+
+// Resource cache that is used by resource list channels. Nil when the cache is disabled.
+var resourceCache *ResourceCache
+
+// UseResourceCache makes resource list channels read from the given cache. Passing nil disables
+// the cache, so that all resource lists are fetched directly from the apiserver.
+func UseResourceCache(resourceCacheToUse *ResourceCache) {
+	resourceCache = resourceCacheToUse
+}
+
+// NewResourceCache creates a cache of all resource kinds used by resource list channels. Every
+// store is fully resynced with the apiserver every resyncPeriod. The cache does not watch anything
+// until Run is called.
+func NewResourceCache(client client.Interface, resyncPeriod time.Duration) *ResourceCache {
+	return &ResourceCache{
+		replicationControllers: newCachedResource(&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return client.ReplicationControllers(api.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return client.ReplicationControllers(api.NamespaceAll).Watch(options)
+			},
+		}, &api.ReplicationController{}, resyncPeriod),
+		replicaSets: newCachedResource(&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return client.Extensions().ReplicaSets(api.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return client.Extensions().ReplicaSets(api.NamespaceAll).Watch(options)
+			},
+		}, &extensions.ReplicaSet{}, resyncPeriod),
+		deployments: newCachedResource(&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return client.Extensions().Deployments(api.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return client.Extensions().Deployments(api.NamespaceAll).Watch(options)
+			},
+		}, &extensions.Deployment{}, resyncPeriod),
+		services: newCachedResource(&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return client.Services(api.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return client.Services(api.NamespaceAll).Watch(options)
+			},
+		}, &api.Service{}, resyncPeriod),
+		pods: newCachedResource(&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return client.Pods(api.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return client.Pods(api.NamespaceAll).Watch(options)
+			},
+		}, &api.Pod{}, resyncPeriod),
+		events: newCachedResource(&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return client.Events(api.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return client.Events(api.NamespaceAll).Watch(options)
+			},
+		}, &api.Event{}, resyncPeriod),
+		nodes: newCachedResource(&cache.ListWatch{
+			ListFunc: func(options api.ListOptions) (runtime.Object, error) {
+				return client.Nodes().List(options)
+			},
+			WatchFunc: func(options api.ListOptions) (watch.Interface, error) {
+				return client.Nodes().Watch(options)
+			},
+		}, &api.Node{}, resyncPeriod),
+	}
+}
+
+// Creates store and reflector for a single resource kind.
+func newCachedResource(listWatch cache.ListerWatcher, expectedType runtime.Object,
+	resyncPeriod time.Duration) *cachedResource {
+
+	resource := &cachedResource{Store: cache.NewStore(cache.MetaNamespaceKeyFunc)}
+	resource.reflector = cache.NewReflector(listWatch, expectedType, resource, resyncPeriod)
+	return resource
+}
+
+// Run starts watching all cached resource kinds in the background. Watches are stopped when
+// stopCh is closed.
+func (c *ResourceCache) Run(stopCh <-chan struct{}) {
+	log.Printf("Starting resource cache")
+
+	for _, resource := range c.all() {
+		resource.reflector.RunUntil(stopCh)
+	}
+
+	go func() {
+		ticker := time.NewTicker(syncCheckPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+				if c.HasSynced() {
+					log.Printf("Resource cache synced")
+					return
+				}
+			}
+		}
+	}()
+}
+
+// HasSynced returns true when every cached resource kind was listed at least once.
+func (c *ResourceCache) HasSynced() bool {
+	for _, resource := range c.all() {
+		if !resource.hasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *ResourceCache) all() []*cachedResource {
+	return []*cachedResource{c.replicationControllers, c.replicaSets, c.deployments,
+		c.services, c.pods, c.events, c.nodes}
+}
+
+// Replace replaces the store contents with the given list. Reflector calls it after every list
+// call, so the store is synced once it was called for the first time.
+func (r *cachedResource) Replace(list []interface{}, resourceVersion string) error {
+	if err := r.Store.Replace(list, resourceVersion); err != nil {
+		return err
+	}
+	atomic.StoreInt32(&r.synced, 1)
+	return nil
+}
+
+// Returns true when the store was filled by the initial list call of its reflector.
+func (r *cachedResource) hasSynced() bool {
+	return atomic.LoadInt32(&r.synced) == 1
+}
+
+// Returns all objects from the store that are in the given namespace, or in all namespaces when it
+// is api.NamespaceAll. Returns false when the cache is not yet synced.
+func (r *cachedResource) list(namespace string) ([]interface{}, bool) {
+	if !r.hasSynced() {
+		return nil, false
+	}
+	if namespace == api.NamespaceAll {
+		return r.List(), true
+	}
+
+	objects := make([]interface{}, 0)
+	for _, object := range r.List() {
+		accessor, err := meta.Accessor(object)
+		if err == nil && accessor.GetNamespace() == namespace {
+			objects = append(objects, object)
+		}
+	}
+	return objects, true
+}
+
+// ReplicationControllerList returns cached list of Replication Controllers in the given namespace,
+// or in all namespaces when it is api.NamespaceAll. The second return value is false when there is
+// nothing that can be served from the cache.
+func (c *ResourceCache) ReplicationControllerList(namespace string) (
+	*api.ReplicationControllerList, bool) {
+
+	if c == nil {
+		return nil, false
+	}
+	objects, ok := c.replicationControllers.list(namespace)
+	if !ok {
+		return nil, false
+	}
+	list := &api.ReplicationControllerList{Items: make([]api.ReplicationController, 0)}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*api.ReplicationController))
+	}
+	return list, true
+}
+
+// ReplicaSetList returns cached list of Replica Sets in the given namespace, or in all namespaces
+// when it is api.NamespaceAll. The second return value is false when there is nothing that can be
+// served from the cache.
+func (c *ResourceCache) ReplicaSetList(namespace string) (*extensions.ReplicaSetList, bool) {
+	if c == nil {
+		return nil, false
+	}
+	objects, ok := c.replicaSets.list(namespace)
+	if !ok {
+		return nil, false
+	}
+	list := &extensions.ReplicaSetList{Items: make([]extensions.ReplicaSet, 0)}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*extensions.ReplicaSet))
+	}
+	return list, true
+}
+
+// DeploymentList returns cached list of Deployments in the given namespace, or in all namespaces
+// when it is api.NamespaceAll. The second return value is false when there is nothing that can be
+// served from the cache.
+func (c *ResourceCache) DeploymentList(namespace string) (*extensions.DeploymentList, bool) {
+	if c == nil {
+		return nil, false
+	}
+	objects, ok := c.deployments.list(namespace)
+	if !ok {
+		return nil, false
+	}
+	list := &extensions.DeploymentList{Items: make([]extensions.Deployment, 0)}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*extensions.Deployment))
+	}
+	return list, true
+}
+
+// ServiceList returns cached list of Services in the given namespace, or in all namespaces when it
+// is api.NamespaceAll. The second return value is false when there is nothing that can be served
+// from the cache.
+func (c *ResourceCache) ServiceList(namespace string) (*api.ServiceList, bool) {
+	if c == nil {
+		return nil, false
+	}
+	objects, ok := c.services.list(namespace)
+	if !ok {
+		return nil, false
+	}
+	list := &api.ServiceList{Items: make([]api.Service, 0)}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*api.Service))
+	}
+	return list, true
+}
+
+// PodList returns cached list of Pods in the given namespace, or in all namespaces when it is
+// api.NamespaceAll. The second return value is false when there is nothing that can be served from
+// the cache.
+func (c *ResourceCache) PodList(namespace string) (*api.PodList, bool) {
+	if c == nil {
+		return nil, false
+	}
+	objects, ok := c.pods.list(namespace)
+	if !ok {
+		return nil, false
+	}
+	list := &api.PodList{Items: make([]api.Pod, 0)}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*api.Pod))
+	}
+	return list, true
+}
+
+// EventList returns cached list of Events in the given namespace, or in all namespaces when it is
+// api.NamespaceAll. The second return value is false when there is nothing that can be served from
+// the cache.
+func (c *ResourceCache) EventList(namespace string) (*api.EventList, bool) {
+	if c == nil {
+		return nil, false
+	}
+	objects, ok := c.events.list(namespace)
+	if !ok {
+		return nil, false
+	}
+	list := &api.EventList{Items: make([]api.Event, 0)}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*api.Event))
+	}
+	return list, true
+}
+
+// NodeList returns cached list of all Nodes. The second return value is false when there is nothing
+// that can be served from the cache.
+func (c *ResourceCache) NodeList() (*api.NodeList, bool) {
+	if c == nil {
+		return nil, false
+	}
+	objects, ok := c.nodes.list(api.NamespaceAll)
+	if !ok {
+		return nil, false
+	}
+	list := &api.NodeList{Items: make([]api.Node, 0)}
+	for _, object := range objects {
+		list.Items = append(list.Items, *object.(*api.Node))
+	}
+	return list, true
+}
@@ -32,6 +32,9 @@ import (
 //
 // When a channel is nil, it means that no resource list is available for getting.
 //
+// Resource lists are read from the ResourceCache when it is in use and synced, and listed directly
+// from the apiserver otherwise.
+//
 // Each channel pair can be read up to N times. N is specified upon creation of the channels.
 type ResourceChannels struct {
 	// List and error channels to Replication Controllers.
@@ -43,6 +46,24 @@ type ResourceChannels struct {
 	// List and error channels to Deployments.
 	DeploymentList DeploymentListChannel
 
+	// List and error channels to Jobs.
+	JobList JobListChannel
+
+	// List and error channels to Daemon Sets.
+	DaemonSetList DaemonSetListChannel
+
+	// List and error channels to Ingresses.
+	IngressList IngressListChannel
+
+	// List and error channels to Endpoints.
+	EndpointList EndpointListChannel
+
+	// List and error channels to Persistent Volumes.
+	PersistentVolumeList PersistentVolumeListChannel
+
+	// List and error channels to Persistent Volume Claims.
+	PersistentVolumeClaimList PersistentVolumeClaimListChannel
+
 	// List and error channels to Services.
 	ServiceList ServiceListChannel
 
@@ -67,15 +88,21 @@ var listEverything api.ListOptions = api.ListOptions{
 	FieldSelector: fields.Everything(),
 }
 
-// Returns a pair of channels to a Service list and errors that both must be read
-// numReads times.
-func GetServiceListChannel(client client.ServicesNamespacer, numReads int) ServiceListChannel {
+// Returns a pair of channels to a Service list in the given namespace and errors that both must be
+// read numReads times. Lists are fetched from all namespaces when namespace is api.NamespaceAll.
+func GetServiceListChannel(client client.ServicesNamespacer, namespace string,
+	numReads int) ServiceListChannel {
+
 	channel := ServiceListChannel{
 		List:  make(chan *api.ServiceList, numReads),
 		Error: make(chan error, numReads),
 	}
 	go func() {
-		services, err := client.Services(api.NamespaceAll).List(listEverything)
+		services, ok := resourceCache.ServiceList(namespace)
+		var err error
+		if !ok {
+			services, err = client.Services(namespace).List(listEverything)
+		}
 
 		for i := 0; i < numReads; i++ {
 			channel.List <- services
@@ -92,8 +119,7 @@ type NodeListChannel struct {
 	Error chan error
 }
 
-// Returns a pair of channels to a Node list and errors that both must be read
-// numReads times.
+// Returns a pair of channels to a Node list and errors that both must be read numReads times.
 func GetNodeListChannel(client client.NodesInterface, numReads int) NodeListChannel {
 	channel := NodeListChannel{
 		List:  make(chan *api.NodeList, numReads),
@@ -101,7 +127,11 @@ func GetNodeListChannel(client client.NodesInterface, numReads int) NodeListChan
 	}
 
 	go func() {
-		nodes, err := client.Nodes().List(listEverything)
+		nodes, ok := resourceCache.NodeList()
+		var err error
+		if !ok {
+			nodes, err = client.Nodes().List(listEverything)
+		}
 
 		for i := 0; i < numReads; i++ {
 			channel.List <- nodes
@@ -118,16 +148,22 @@ type EventListChannel struct {
 	Error chan error
 }
 
-// Returns a pair of channels to an Event list and errors that both must be read
-// numReads times.
-func GetEventListChannel(client client.EventNamespacer, numReads int) EventListChannel {
+// Returns a pair of channels to an Event list in the given namespace and errors that both must be
+// read numReads times. Lists are fetched from all namespaces when namespace is api.NamespaceAll.
+func GetEventListChannel(client client.EventNamespacer, namespace string,
+	numReads int) EventListChannel {
+
 	channel := EventListChannel{
 		List:  make(chan *api.EventList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		events, err := client.Events(api.NamespaceAll).List(listEverything)
+		events, ok := resourceCache.EventList(namespace)
+		var err error
+		if !ok {
+			events, err = client.Events(namespace).List(listEverything)
+		}
 
 		for i := 0; i < numReads; i++ {
 			channel.List <- events
@@ -144,16 +180,22 @@ type PodListChannel struct {
 	Error chan error
 }
 
-// Returns a pair of channels to a Pod list and errors that both must be read
-// numReads times.
-func GetPodListChannel(client client.PodsNamespacer, numReads int) PodListChannel {
+// Returns a pair of channels to a Pod list in the given namespace and errors that both must be read
+// numReads times. Lists are fetched from all namespaces when namespace is api.NamespaceAll.
+func GetPodListChannel(client client.PodsNamespacer, namespace string,
+	numReads int) PodListChannel {
+
 	channel := PodListChannel{
 		List:  make(chan *api.PodList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		pods, err := client.Pods(api.NamespaceAll).List(listEverything)
+		pods, ok := resourceCache.PodList(namespace)
+		var err error
+		if !ok {
+			pods, err = client.Pods(namespace).List(listEverything)
+		}
 
 		for i := 0; i < numReads; i++ {
 			channel.List <- pods
@@ -170,10 +212,11 @@ type ReplicationControllerListChannel struct {
 	Error chan error
 }
 
-// Returns a pair of channels to a Replication Controller list and errors that both must be read
-// numReads times.
+// Returns a pair of channels to a Replication Controller list in the given namespace and errors
+// that both must be read numReads times. Lists are fetched from all namespaces when namespace is
+// api.NamespaceAll.
 func GetReplicationControllerListChannel(client client.ReplicationControllersNamespacer,
-	numReads int) ReplicationControllerListChannel {
+	namespace string, numReads int) ReplicationControllerListChannel {
 
 	channel := ReplicationControllerListChannel{
 		List:  make(chan *api.ReplicationControllerList, numReads),
@@ -181,7 +224,11 @@ func GetReplicationControllerListChannel(client client.ReplicationControllersNam
 	}
 
 	go func() {
-		rcs, err := client.ReplicationControllers(api.NamespaceAll).List(listEverything)
+		rcs, ok := resourceCache.ReplicationControllerList(namespace)
+		var err error
+		if !ok {
+			rcs, err = client.ReplicationControllers(namespace).List(listEverything)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- rcs
 			channel.Error <- err
@@ -197,16 +244,22 @@ type DeploymentListChannel struct {
 	Error chan error
 }
 
-// Returns a pair of channels to a Deployment list and errors that both must be read
-// numReads times.
-func GetDeploymentListChannel(client client.DeploymentsNamespacer, numReads int) DeploymentListChannel {
+// Returns a pair of channels to a Deployment list in the given namespace and errors that both must
+// be read numReads times. Lists are fetched from all namespaces when namespace is api.NamespaceAll.
+func GetDeploymentListChannel(client client.DeploymentsNamespacer, namespace string,
+	numReads int) DeploymentListChannel {
+
 	channel := DeploymentListChannel{
 		List:  make(chan *extensions.DeploymentList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		rcs, err := client.Deployments(api.NamespaceAll).List(listEverything)
+		rcs, ok := resourceCache.DeploymentList(namespace)
+		var err error
+		if !ok {
+			rcs, err = client.Deployments(namespace).List(listEverything)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- rcs
 			channel.Error <- err
@@ -222,16 +275,22 @@ type ReplicaSetListChannel struct {
 	Error chan error
 }
 
-// Returns a pair of channels to a ReplicaSet list and errors that both must be read
-// numReads times.
-func GetReplicaSetListChannel(client client.ReplicaSetsNamespacer, numReads int) ReplicaSetListChannel {
+// Returns a pair of channels to a ReplicaSet list in the given namespace and errors that both must
+// be read numReads times. Lists are fetched from all namespaces when namespace is api.NamespaceAll.
+func GetReplicaSetListChannel(client client.ReplicaSetsNamespacer, namespace string,
+	numReads int) ReplicaSetListChannel {
+
 	channel := ReplicaSetListChannel{
 		List:  make(chan *extensions.ReplicaSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		rcs, err := client.ReplicaSets(api.NamespaceAll).List(listEverything)
+		rcs, ok := resourceCache.ReplicaSetList(namespace)
+		var err error
+		if !ok {
+			rcs, err = client.ReplicaSets(namespace).List(listEverything)
+		}
 		for i := 0; i < numReads; i++ {
 			channel.List <- rcs
 			channel.Error <- err
@@ -240,3 +299,169 @@ func GetReplicaSetListChannel(client client.ReplicaSetsNamespacer, numReads int)
 
 	return channel
 }
+
+// List and error channels to Jobs.
+type JobListChannel struct {
+	List  chan *extensions.JobList
+	Error chan error
+}
+
+// Returns a pair of channels to a Job list in the given namespace and errors that both must be
+// read numReads times. Lists are fetched from all namespaces when namespace is api.NamespaceAll.
+func GetJobListChannel(client client.JobsNamespacer, namespace string,
+	numReads int) JobListChannel {
+
+	channel := JobListChannel{
+		List:  make(chan *extensions.JobList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		jobs, err := client.Jobs(namespace).List(listEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- jobs
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// List and error channels to Daemon Sets.
+type DaemonSetListChannel struct {
+	List  chan *extensions.DaemonSetList
+	Error chan error
+}
+
+// Returns a pair of channels to a Daemon Set list in the given namespace and errors that both must
+// be read numReads times. Lists are fetched from all namespaces when namespace is
+// api.NamespaceAll.
+func GetDaemonSetListChannel(client client.DaemonSetsNamespacer, namespace string,
+	numReads int) DaemonSetListChannel {
+
+	channel := DaemonSetListChannel{
+		List:  make(chan *extensions.DaemonSetList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		daemonSets, err := client.DaemonSets(namespace).List(listEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- daemonSets
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// List and error channels to Ingresses.
+type IngressListChannel struct {
+	List  chan *extensions.IngressList
+	Error chan error
+}
+
+// Returns a pair of channels to an Ingress list in the given namespace and errors that both must
+// be read numReads times. Lists are fetched from all namespaces when namespace is
+// api.NamespaceAll.
+func GetIngressListChannel(client client.IngressNamespacer, namespace string,
+	numReads int) IngressListChannel {
+
+	channel := IngressListChannel{
+		List:  make(chan *extensions.IngressList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		ingresses, err := client.Ingress(namespace).List(listEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- ingresses
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// List and error channels to Endpoints.
+type EndpointListChannel struct {
+	List  chan *api.EndpointsList
+	Error chan error
+}
+
+// Returns a pair of channels to an Endpoints list in the given namespace and errors that both must
+// be read numReads times. Lists are fetched from all namespaces when namespace is
+// api.NamespaceAll.
+func GetEndpointListChannel(client client.EndpointsNamespacer, namespace string,
+	numReads int) EndpointListChannel {
+
+	channel := EndpointListChannel{
+		List:  make(chan *api.EndpointsList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		endpoints, err := client.Endpoints(namespace).List(listEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- endpoints
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// List and error channels to Persistent Volumes.
+type PersistentVolumeListChannel struct {
+	List  chan *api.PersistentVolumeList
+	Error chan error
+}
+
+// Returns a pair of channels to a Persistent Volume list and errors that both must be read
+// numReads times.
+func GetPersistentVolumeListChannel(client client.PersistentVolumesInterface,
+	numReads int) PersistentVolumeListChannel {
+
+	channel := PersistentVolumeListChannel{
+		List:  make(chan *api.PersistentVolumeList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		persistentVolumes, err := client.PersistentVolumes().List(listEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- persistentVolumes
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
+
+// List and error channels to Persistent Volume Claims.
+type PersistentVolumeClaimListChannel struct {
+	List  chan *api.PersistentVolumeClaimList
+	Error chan error
+}
+
+// Returns a pair of channels to a Persistent Volume Claim list in the given namespace and errors
+// that both must be read numReads times. Lists are fetched from all namespaces when namespace is
+// api.NamespaceAll.
+func GetPersistentVolumeClaimListChannel(client client.PersistentVolumeClaimsNamespacer,
+	namespace string, numReads int) PersistentVolumeClaimListChannel {
+
+	channel := PersistentVolumeClaimListChannel{
+		List:  make(chan *api.PersistentVolumeClaimList, numReads),
+		Error: make(chan error, numReads),
+	}
+
+	go func() {
+		claims, err := client.PersistentVolumeClaims(namespace).List(listEverything)
+		for i := 0; i < numReads; i++ {
+			channel.List <- claims
+			channel.Error <- err
+		}
+	}()
+
+	return channel
+}
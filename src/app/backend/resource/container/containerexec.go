@@ -0,0 +1,222 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/restclient"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/remotecommand"
+)
+
+// Operations of terminal messages exchanged between a client and a terminal session.
+const (
+	// Input typed by the user, sent by the client.
+	StdinOp = "stdin"
+
+	// Output of the command, sent by the server.
+	StdoutOp = "stdout"
+
+	// Error output of the command, sent by the server when the session does not use a TTY.
+	StderrOp = "stderr"
+
+	// New size of the user's terminal, sent by the client. Resizing is not supported, as the
+	// remote command protocol of the apiserver does not carry terminal size. Every resize message
+	// is answered with an error message and the terminal keeps its size.
+	ResizeOp = "resize"
+
+	// Error that does not end the session, e.g., a rejected resize, sent by the server.
+	ErrorOp = "error"
+)
+
+// ResizeNotSupportedMessage is sent to the client in response to resize messages.
+const ResizeNotSupportedMessage = "Terminal resize is not supported"
+
+// DefaultExecCommand is the command executed in the container when none is given.
+var DefaultExecCommand = []string{"/bin/sh"}
+
+// ExecSpec is a specification of a command executed in a container.
+type ExecSpec struct {
+	// Name of the container the command is executed in. When empty, the first container of the
+	// pod is used.
+	Container string
+
+	// Command with its arguments.
+	Command []string
+
+	// Whether the command is attached to a TTY. When set, error output is sent as standard
+	// output.
+	TTY bool
+}
+
+// TerminalMessage is a JSON message exchanged over the WebSocket connection of a terminal
+// session.
+type TerminalMessage struct {
+	// Operation of the message: stdin, stdout, stderr, resize or error.
+	Op string `json:"op"`
+
+	// Data of stdin, stdout, stderr and error messages.
+	Data string `json:"data,omitempty"`
+
+	// Terminal size of resize messages.
+	Rows uint16 `json:"rows,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+}
+
+// TerminalSession bridges a WebSocket connection to the standard streams of a command executed in
+// a container. Session is closed when no input is received from the client for the given idle
+// timeout.
+type TerminalSession struct {
+	conn        *websocket.Conn
+	idleTimeout time.Duration
+	idleTimer   *time.Timer
+
+	// Input received from the client that was not read yet.
+	pending []byte
+
+	// Serializes writes to the connection, as stdout and stderr are written concurrently.
+	writeLock sync.Mutex
+}
+
+// NewTerminalSession creates terminal session for the given WebSocket connection.
+func NewTerminalSession(conn *websocket.Conn, idleTimeout time.Duration) *TerminalSession {
+	session := &TerminalSession{
+		conn:        conn,
+		idleTimeout: idleTimeout,
+	}
+	session.idleTimer = time.AfterFunc(idleTimeout, func() {
+		log.Printf("Closing terminal session idle for %s", idleTimeout)
+		session.Close()
+	})
+	return session
+}
+
+// Read reads user input from the client. It implements io.Reader and is used as standard input
+// of the command.
+func (session *TerminalSession) Read(p []byte) (int, error) {
+	for len(session.pending) == 0 {
+		message := TerminalMessage{}
+		if err := websocket.JSON.Receive(session.conn, &message); err != nil {
+			return 0, err
+		}
+		session.idleTimer.Reset(session.idleTimeout)
+
+		switch message.Op {
+		case StdinOp:
+			session.pending = []byte(message.Data)
+		case ResizeOp:
+			if err := session.send(ErrorOp, []byte(ResizeNotSupportedMessage)); err != nil {
+				return 0, err
+			}
+		default:
+			return 0, fmt.Errorf("Unknown terminal message operation: %s", message.Op)
+		}
+	}
+
+	n := copy(p, session.pending)
+	session.pending = session.pending[n:]
+	return n, nil
+}
+
+// Stdout returns writer that sends command output to the client.
+func (session *TerminalSession) Stdout() io.Writer {
+	return &terminalWriter{session, StdoutOp}
+}
+
+// Stderr returns writer that sends command error output to the client.
+func (session *TerminalSession) Stderr() io.Writer {
+	return &terminalWriter{session, StderrOp}
+}
+
+// Close closes the session and its WebSocket connection.
+func (session *TerminalSession) Close() error {
+	session.idleTimer.Stop()
+	return session.conn.Close()
+}
+
+// Sends data to the client as a message with the given operation.
+func (session *TerminalSession) send(op string, data []byte) error {
+	session.writeLock.Lock()
+	defer session.writeLock.Unlock()
+	return websocket.JSON.Send(session.conn, TerminalMessage{Op: op, Data: string(data)})
+}
+
+// Writer of one of the output streams of a terminal session.
+type terminalWriter struct {
+	session *TerminalSession
+	op      string
+}
+
+func (writer *terminalWriter) Write(p []byte) (int, error) {
+	if err := writer.session.send(writer.op, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ExecInContainer executes command in a container of the given pod and streams its input and
+// output through the terminal session until the command exits or the session is closed.
+func ExecInContainer(client *client.Client, config *restclient.Config, namespace, podId string,
+	spec ExecSpec, session *TerminalSession) error {
+	log.Printf("Executing %v in %s container of %s pod in %s namespace", spec.Command,
+		spec.Container, podId, namespace)
+
+	pod, err := client.Pods(namespace).Get(podId)
+	if err != nil {
+		return err
+	}
+
+	execOptions := getExecOptions(pod, spec)
+	req := client.RESTClient.Post().
+		Namespace(namespace).
+		Name(podId).
+		Resource("pods").
+		SubResource("exec").
+		VersionedParams(execOptions, api.ParameterCodec)
+
+	executor, err := remotecommand.NewExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	return executor.Stream(session, session.Stdout(), session.Stderr(), execOptions.TTY)
+}
+
+// Returns pod exec options for the given spec with container defaulted to the first container of
+// the pod and command defaulted to a shell.
+func getExecOptions(pod *api.Pod, spec ExecSpec) *api.PodExecOptions {
+	execOptions := &api.PodExecOptions{
+		Container: spec.Container,
+		Command:   spec.Command,
+		Stdin:     true,
+		Stdout:    true,
+		Stderr:    !spec.TTY,
+		TTY:       spec.TTY,
+	}
+	if len(execOptions.Container) == 0 && len(pod.Spec.Containers) > 0 {
+		execOptions.Container = pod.Spec.Containers[0].Name
+	}
+	if len(execOptions.Command) == 0 {
+		execOptions.Command = DefaultExecCommand
+	}
+	return execOptions
+}
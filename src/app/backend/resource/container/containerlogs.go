@@ -15,12 +15,14 @@
 package container
 
 import (
+	"io"
 	"io/ioutil"
 	"log"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/restclient"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
@@ -40,48 +42,80 @@ type Logs struct {
 }
 
 // GetPodLogs returns logs for particular pod and container or error when occurred. When container
-// is null, logs for the first one are returned.
-func GetPodLogs(client *client.Client, namespace, podId string, container string) (*Logs, error) {
-	log.Printf("Getting logs from %s container from %s pod in %s namespace", container, podId,
-		namespace)
+// is not set in the log options, logs for the first one are returned.
+func GetPodLogs(client *client.Client, namespace, podId string, logOptions *api.PodLogOptions) (
+	*Logs, error) {
+	log.Printf("Getting logs from %s container from %s pod in %s namespace", logOptions.Container,
+		podId, namespace)
 
 	pod, err := client.Pods(namespace).Get(podId)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(container) == 0 {
-		container = pod.Spec.Containers[0].Name
+	logOptions = getLogOptions(pod, logOptions)
+	logOptions.Follow = false
+
+	rawLogs, err := getRawPodLogs(client, namespace, podId, logOptions)
+	if err != nil {
+		return nil, err
 	}
 
-	logOptions := &api.PodLogOptions{
-		Container:  container,
-		Follow:     false,
-		Previous:   false,
-		Timestamps: true,
+	sinceTime := pod.CreationTimestamp
+	if logOptions.SinceTime != nil {
+		sinceTime = *logOptions.SinceTime
 	}
 
-	rawLogs, err := getRawPodLogs(client, namespace, podId, logOptions)
+	return ConstructLogs(podId, sinceTime, rawLogs, logOptions.Container), nil
+}
+
+// StreamPodLogs opens a stream of logs for particular pod and container that keeps being written
+// to as long as the container runs. When container is not set in the log options, logs for the
+// first one are streamed. It is up to the caller to close the returned stream.
+func StreamPodLogs(client *client.Client, namespace, podId string,
+	logOptions *api.PodLogOptions) (io.ReadCloser, error) {
+	log.Printf("Streaming logs from %s container from %s pod in %s namespace",
+		logOptions.Container, podId, namespace)
+
+	pod, err := client.Pods(namespace).Get(podId)
 	if err != nil {
 		return nil, err
 	}
 
-	return ConstructLogs(podId, pod.CreationTimestamp, rawLogs, container), nil
+	logOptions = getLogOptions(pod, logOptions)
+	logOptions.Follow = true
+
+	return getPodLogsRequest(client, namespace, podId, logOptions).Stream()
 }
 
-// Construct a request for getting the logs for a pod and retrieves the logs.
-func getRawPodLogs(client *client.Client, namespace, podID string, logOptions *api.PodLogOptions) (
-	string, error) {
-	req := client.RESTClient.Get().
+// Returns copy of the given log options with container defaulted to the first container of the
+// pod and timestamps turned on.
+func getLogOptions(pod *api.Pod, logOptions *api.PodLogOptions) *api.PodLogOptions {
+	result := *logOptions
+	if len(result.Container) == 0 && len(pod.Spec.Containers) > 0 {
+		result.Container = pod.Spec.Containers[0].Name
+	}
+	result.Timestamps = true
+	return &result
+}
+
+// Construct a request for getting the logs for a pod.
+func getPodLogsRequest(client *client.Client, namespace, podID string,
+	logOptions *api.PodLogOptions) *restclient.Request {
+	return client.RESTClient.Get().
 		Namespace(namespace).
 		Name(podID).
 		Resource("pods").
 		SubResource("log").
 		VersionedParams(logOptions, api.ParameterCodec)
+}
 
-	readCloser, err := req.Stream()
+// Construct a request for getting the logs for a pod and retrieves the logs.
+func getRawPodLogs(client *client.Client, namespace, podID string, logOptions *api.PodLogOptions) (
+	string, error) {
+	readCloser, err := getPodLogsRequest(client, namespace, podID, logOptions).Stream()
 	if err != nil {
-		return err.Error(), nil
+		return "", err
 	}
 
 	defer readCloser.Close()
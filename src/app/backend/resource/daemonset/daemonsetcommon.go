@@ -0,0 +1,101 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"sort"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+)
+
+// NodePods is aggregate information about the pods of a Daemon Set that are scheduled to a single
+// node.
+type NodePods struct {
+	// Name of the node. Empty for pods that are not scheduled yet.
+	NodeName string `json:"nodeName"`
+
+	// Names of the pods of the Daemon Set on the node.
+	PodNames []string `json:"podNames"`
+
+	// Aggregate information about the pods on the node. Exactly one pod is desired per node.
+	PodInfo common.PodInfo `json:"podInfo"`
+}
+
+// Returns aggregate information about the given pods of the daemon set. Desired number of pods is
+// the number of nodes that should be running the daemon pod.
+func getPodInfo(daemonSet *extensions.DaemonSet, pods []api.Pod) common.PodInfo {
+	return common.GetPodInfo(daemonSet.Status.CurrentNumberScheduled,
+		daemonSet.Status.DesiredNumberScheduled, pods)
+}
+
+// Groups the given pods of a daemon set by nodes they are scheduled to. The result is sorted by
+// node names.
+func getPodsPerNode(pods []api.Pod) []NodePods {
+	podsByNode := make(map[string][]api.Pod)
+	for _, pod := range pods {
+		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+	}
+
+	nodeNames := make([]string, 0, len(podsByNode))
+	for nodeName := range podsByNode {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+
+	result := make([]NodePods, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		nodePods := podsByNode[nodeName]
+		podNames := make([]string, 0, len(nodePods))
+		for _, pod := range nodePods {
+			podNames = append(podNames, pod.Name)
+		}
+		result = append(result, NodePods{
+			NodeName: nodeName,
+			PodNames: podNames,
+			PodInfo:  common.GetPodInfo(len(nodePods), 1, nodePods),
+		})
+	}
+	return result
+}
+
+// Returns the daemon set with the given name together with its pods.
+func getRawDaemonSetWithPods(client client.Interface, namespace, name string) (
+	*extensions.DaemonSet, []api.Pod, error) {
+
+	daemonSet, err := client.Extensions().DaemonSets(namespace).Get(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	labelSelector, err := unversioned.LabelSelectorAsSelector(daemonSet.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pods, err := client.Pods(namespace).List(api.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return daemonSet, pods.Items, nil
+}
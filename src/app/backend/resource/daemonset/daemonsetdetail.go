@@ -0,0 +1,108 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/pod"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// DaemonSetDetail represents detailed information about a Daemon Set.
+type DaemonSetDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Label selector of the Daemon Set.
+	Selector *unversioned.LabelSelector `json:"selector"`
+
+	// Node selector of the pod template. Daemon pods run only on nodes matching all of its labels.
+	// Nil when they run on every node.
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// Container image list of the pod template specified by this Daemon Set.
+	ContainerImages []string `json:"containerImages"`
+
+	// Number of nodes that should be running the daemon pod.
+	DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+
+	// Number of nodes that are running the daemon pod and are supposed to run it.
+	CurrentNumberScheduled int `json:"currentNumberScheduled"`
+
+	// Number of nodes that are running the daemon pod but are not supposed to run it.
+	NumberMisscheduled int `json:"numberMisscheduled"`
+
+	// Aggregate information about pods of this Daemon Set.
+	PodInfo common.PodInfo `json:"podInfo"`
+
+	// Pods of this Daemon Set grouped by nodes they are scheduled to.
+	PodsPerNode []NodePods `json:"podsPerNode"`
+
+	// Detailed information about Pods belonging to this Daemon Set.
+	Pods pod.PodList `json:"pods"`
+
+	// Events related to the Daemon Set.
+	Events event.Events `json:"events"`
+}
+
+// GetDaemonSetDetail returns detailed information about the given daemon set in the given
+// namespace.
+func GetDaemonSetDetail(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace, name string) (*DaemonSetDetail, error) {
+	log.Printf("Getting details of %s daemon set in %s namespace", name, namespace)
+
+	daemonSet, pods, err := getRawDaemonSetWithPods(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := event.GetObjectEvents(client, namespace, "DaemonSet", name)
+	if err != nil {
+		return nil, err
+	}
+
+	daemonSetDetail := getDaemonSetDetail(daemonSet, pods, events)
+	daemonSetDetail.Pods = pod.CreatePodList(pods, common.NoDataSelect, heapsterClient)
+
+	return daemonSetDetail, nil
+}
+
+func getDaemonSetDetail(daemonSet *extensions.DaemonSet, pods []api.Pod,
+	events []api.Event) *DaemonSetDetail {
+
+	podInfo := getPodInfo(daemonSet, pods)
+	podInfo.Warnings = event.GetPodsEventWarnings(events, pods)
+
+	return &DaemonSetDetail{
+		ObjectMeta:             common.CreateObjectMeta(daemonSet.ObjectMeta),
+		TypeMeta:               common.CreateTypeMeta(daemonSet.TypeMeta),
+		Selector:               daemonSet.Spec.Selector,
+		NodeSelector:           daemonSet.Spec.Template.Spec.NodeSelector,
+		ContainerImages:        common.GetContainerImages(&daemonSet.Spec.Template.Spec),
+		DesiredNumberScheduled: daemonSet.Status.DesiredNumberScheduled,
+		CurrentNumberScheduled: daemonSet.Status.CurrentNumberScheduled,
+		NumberMisscheduled:     daemonSet.Status.NumberMisscheduled,
+		PodInfo:                podInfo,
+		PodsPerNode:            getPodsPerNode(pods),
+		Events:                 event.CreateEvents(events, daemonSet.Namespace),
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// DaemonSetList contains a list of Daemon Sets in the cluster.
+type DaemonSetList struct {
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Daemon Sets.
+	DaemonSets []DaemonSet `json:"daemonSets"`
+}
+
+// DaemonSet is a presentation layer view of Kubernetes Daemon Set resource. This means it is
+// Daemon Set plus additional augumented data we can get from other sources (like warnings of its
+// pods).
+type DaemonSet struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Aggregate information about pods belonging to this Daemon Set.
+	Pods common.PodInfo `json:"pods"`
+
+	// Container images of the Daemon Set.
+	ContainerImages []string `json:"containerImages"`
+
+	// Number of nodes that should be running the daemon pod.
+	DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+
+	// Number of nodes that are running the daemon pod and are supposed to run it.
+	CurrentNumberScheduled int `json:"currentNumberScheduled"`
+
+	// Number of nodes that are running the daemon pod but are not supposed to run it.
+	NumberMisscheduled int `json:"numberMisscheduled"`
+}
+
+// GetDaemonSetList returns a list of Daemon Sets in the given namespace, or in all namespaces when
+// it is api.NamespaceAll.
+func GetDaemonSetList(client client.Interface, namespace string,
+	dsQuery *common.DataSelectQuery) (*DaemonSetList, error) {
+	log.Printf("Getting list of daemon sets in %s", common.DescribeNamespace(namespace))
+
+	channels := &common.ResourceChannels{
+		DaemonSetList: common.GetDaemonSetListChannel(client.Extensions(), namespace, 1),
+		PodList:       common.GetPodListChannel(client, namespace, 1),
+		EventList:     common.GetEventListChannel(client, namespace, 1),
+	}
+
+	return GetDaemonSetListFromChannels(channels, dsQuery)
+}
+
+// GetDaemonSetListFromChannels returns a list of all Daemon Sets in the cluster reading required
+// resource list once from the channels.
+func GetDaemonSetListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*DaemonSetList, error) {
+
+	daemonSets := <-channels.DaemonSetList.List
+	if err := <-channels.DaemonSetList.Error; err != nil {
+		statusErr, ok := err.(*k8serrors.StatusError)
+		if ok && statusErr.ErrStatus.Reason == "NotFound" {
+			// NotFound - this means that the server does not support Daemon Set objects, which
+			// is fine.
+			emptyList := &DaemonSetList{
+				DaemonSets: make([]DaemonSet, 0),
+			}
+			return emptyList, nil
+		}
+		return nil, err
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	events := <-channels.EventList.List
+	if err := <-channels.EventList.Error; err != nil {
+		return nil, err
+	}
+
+	return getDaemonSetList(daemonSets.Items, pods.Items, events.Items, dsQuery), nil
+}
+
+func getDaemonSetList(daemonSets []extensions.DaemonSet, pods []api.Pod, events []api.Event,
+	dsQuery *common.DataSelectQuery) *DaemonSetList {
+
+	daemonSets, listMeta := selectDaemonSets(daemonSets, dsQuery)
+	daemonSetList := &DaemonSetList{
+		ListMeta:   listMeta,
+		DaemonSets: make([]DaemonSet, 0),
+	}
+
+	for _, daemonSet := range daemonSets {
+		matchingPods := common.GetMatchingPods(daemonSet.Spec.Selector,
+			daemonSet.ObjectMeta.Namespace, pods)
+		podInfo := getPodInfo(&daemonSet, matchingPods)
+		podInfo.Warnings = event.GetPodsEventWarnings(events, matchingPods)
+
+		daemonSetList.DaemonSets = append(daemonSetList.DaemonSets,
+			ToDaemonSet(&daemonSet, &podInfo))
+	}
+
+	return daemonSetList
+}
+
+// ToDaemonSet returns presentation layer view of the given Daemon Set with the given aggregate
+// information about its pods.
+func ToDaemonSet(daemonSet *extensions.DaemonSet, podInfo *common.PodInfo) DaemonSet {
+	return DaemonSet{
+		ObjectMeta:             common.CreateObjectMeta(daemonSet.ObjectMeta),
+		TypeMeta:               common.CreateTypeMeta(daemonSet.TypeMeta),
+		ContainerImages:        common.GetContainerImages(&daemonSet.Spec.Template.Spec),
+		Pods:                   *podInfo,
+		DesiredNumberScheduled: daemonSet.Status.DesiredNumberScheduled,
+		CurrentNumberScheduled: daemonSet.Status.CurrentNumberScheduled,
+		NumberMisscheduled:     daemonSet.Status.NumberMisscheduled,
+	}
+}
+
+// Daemon Set list item that data select queries are applied to.
+type daemonSetCell extensions.DaemonSet
+
+func (d daemonSetCell) GetObjectMeta() api.ObjectMeta {
+	return d.ObjectMeta
+}
+
+func (d daemonSetCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	return nil
+}
+
+// Returns Daemon Sets selected by the query together with metadata of the whole filtered list.
+func selectDaemonSets(daemonSets []extensions.DaemonSet,
+	dsQuery *common.DataSelectQuery) ([]extensions.DaemonSet, common.ListMeta) {
+
+	cells := make([]common.DataCell, len(daemonSets))
+	for i := range daemonSets {
+		cells[i] = daemonSetCell(daemonSets[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]extensions.DaemonSet, len(cells))
+	for i := range cells {
+		result[i] = extensions.DaemonSet(cells[i].(daemonSetCell))
+	}
+	return result, listMeta
+}
@@ -18,6 +18,9 @@ import (
 	"github.com/kubernetes/dashboard/resource/common"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 )
 
 // getPodInfo returns aggregate information about deployment pods.
@@ -26,3 +29,35 @@ func getPodInfo(resource *extensions.Deployment,
 
 	return common.GetPodInfo(resource.Status.Replicas, resource.Spec.Replicas, pods)
 }
+
+// Based on given selector returns list of services that are candidates for deletion.
+// Services are matched by deployments' label selector. They are deleted if given
+// label selector is targeting only 1 deployment.
+func getServicesForDeletion(client client.Interface, labelSelector labels.Selector,
+	namespace string) ([]api.Service, error) {
+
+	deployments, err := client.Extensions().Deployments(namespace).List(api.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// if label selector is targeting only 1 deployment
+	// then we can delete services targeted by this label selector,
+	// otherwise we can not delete any services so just return empty list
+	if len(deployments.Items) != 1 {
+		return []api.Service{}, nil
+	}
+
+	services, err := client.Services(namespace).List(api.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return services.Items, nil
+}
@@ -0,0 +1,411 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/pod"
+	"github.com/kubernetes/dashboard/resource/replicaset"
+	resourceService "github.com/kubernetes/dashboard/resource/service"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// RollingUpdateStrategy is behavior of a rolling update. See RollingUpdateDeployment K8s object.
+type RollingUpdateStrategy struct {
+	// Maximum number of pods that can be scheduled above the desired number of pods during the
+	// update. Absolute number or percentage of desired pods.
+	MaxSurge intstr.IntOrString `json:"maxSurge"`
+
+	// Maximum number of pods that can be unavailable during the update. Absolute number or
+	// percentage of desired pods.
+	MaxUnavailable intstr.IntOrString `json:"maxUnavailable"`
+}
+
+// DeploymentStatusInfo describes progress of the rollout of a Deployment.
+type DeploymentStatusInfo struct {
+	// Total number of non-terminated pods targeted by this deployment.
+	Replicas int `json:"replicas"`
+
+	// Total number of non-terminated pods targeted by this deployment that have the desired
+	// template spec.
+	Updated int `json:"updated"`
+
+	// Total number of available pods (ready for at least minReadySeconds) targeted by this
+	// deployment.
+	Available int `json:"available"`
+
+	// Total number of unavailable pods targeted by this deployment.
+	Unavailable int `json:"unavailable"`
+}
+
+// ReplicaSetRevision is a Replica Set owned by a Deployment together with the Deployment revision
+// it was created for.
+type ReplicaSetRevision struct {
+	// Revision of the Deployment that the Replica Set corresponds to. 0 when unknown.
+	Revision int64 `json:"revision"`
+
+	// The Replica Set.
+	ReplicaSet replicaset.ReplicaSet `json:"replicaSet"`
+}
+
+// DeploymentDetail is a presentation layer view of Kubernetes Deployment resource. This means it
+// is Deployment plus additional augumented data we can get from other sources (like replica sets
+// it owns and services that target the same pods).
+type DeploymentDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Label selector of the Deployment.
+	Selector *unversioned.LabelSelector `json:"selector"`
+
+	// Rollout progress of the Deployment.
+	StatusInfo DeploymentStatusInfo `json:"statusInfo"`
+
+	// The deployment strategy to use to replace existing pods with new ones.
+	// Valid options: Recreate, RollingUpdate
+	Strategy extensions.DeploymentStrategyType `json:"strategy"`
+
+	// Min ready seconds
+	MinReadySeconds int `json:"minReadySeconds"`
+
+	// Rolling update strategy parameters. Nil for other strategies.
+	RollingUpdateStrategy *RollingUpdateStrategy `json:"rollingUpdateStrategy"`
+
+	// Number of old replica sets to retain to allow rollback. Nil means all of them are kept.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit"`
+
+	// Replica Set that runs the current pod template of the Deployment. Nil when it has not been
+	// created yet.
+	NewReplicaSet *ReplicaSetRevision `json:"newReplicaSet"`
+
+	// Replica Sets of previous revisions of the Deployment, newest first.
+	OldReplicaSets []ReplicaSetRevision `json:"oldReplicaSets"`
+
+	// Detailed information about Pods belonging to this Deployment.
+	Pods pod.PodList `json:"pods"`
+
+	// Services that target pods of this Deployment.
+	ServiceList resourceService.ServiceList `json:"serviceList"`
+
+	// Events related to the Deployment.
+	Events event.Events `json:"events"`
+}
+
+// DeploymentSpec contains information needed to update deployment.
+type DeploymentSpec struct {
+	// Replicas (pods) number of the deployment
+	Replicas int `json:"replicas"`
+}
+
+// ReplicaSetRevisionSorter sorts replica set revisions from the newest to the oldest one.
+type ReplicaSetRevisionSorter []ReplicaSetRevision
+
+func (a ReplicaSetRevisionSorter) Len() int           { return len(a) }
+func (a ReplicaSetRevisionSorter) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a ReplicaSetRevisionSorter) Less(i, j int) bool { return a[i].Revision > a[j].Revision }
+
+// GetDeploymentDetail returns detailed information about the given deployment in the given
+// namespace.
+func GetDeploymentDetail(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace, name string) (*DeploymentDetail, error) {
+	log.Printf("Getting details of %s deployment in %s namespace", name, namespace)
+
+	deployment, err := client.Extensions().Deployments(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := &common.ResourceChannels{
+		ReplicaSetList: common.GetReplicaSetListChannel(client.Extensions(), namespace, 1),
+		PodList:        common.GetPodListChannel(client, namespace, 1),
+		ServiceList:    common.GetServiceListChannel(client, namespace, 1),
+	}
+
+	replicaSets := <-channels.ReplicaSetList.List
+	if err := <-channels.ReplicaSetList.Error; err != nil {
+		return nil, err
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	services := <-channels.ServiceList.List
+	if err := <-channels.ServiceList.Error; err != nil {
+		return nil, err
+	}
+
+	events, err := event.GetObjectEvents(client, namespace, "Deployment", name)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentDetail, err := getDeploymentDetail(deployment, replicaSets.Items, pods.Items,
+		services.Items, events)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentDetail.Pods = pod.CreatePodList(
+		common.GetMatchingPods(deployment.Spec.Selector, namespace, pods.Items), common.NoDataSelect,
+		heapsterClient)
+
+	return deploymentDetail, nil
+}
+
+// DeleteDeployment deletes deployment with given name in given namespace together with all its
+// replica sets and pods. Also deletes services related to deployment if deleteServices is true.
+func DeleteDeployment(client k8sClient.Interface, namespace, name string,
+	deleteServices bool) error {
+
+	log.Printf("Deleting %s deployment from %s namespace", name, namespace)
+
+	if deleteServices {
+		if err := DeleteDeploymentServices(client, namespace, name); err != nil {
+			return err
+		}
+	}
+
+	deployment, err := client.Extensions().Deployments(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	labelSelector, err := unversioned.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	options := api.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fields.Everything(),
+	}
+
+	replicaSets, err := client.Extensions().ReplicaSets(namespace).List(options)
+	if err != nil {
+		return err
+	}
+
+	pods, err := client.Pods(namespace).List(options)
+	if err != nil {
+		return err
+	}
+
+	// Deployment goes first, so that it does not recreate replica sets deleted below. Same applies
+	// to replica sets and their pods.
+	if err := client.Extensions().Deployments(namespace).Delete(name, &api.DeleteOptions{}); err != nil {
+		return err
+	}
+
+	for _, replicaSet := range replicaSets.Items {
+		if err := client.Extensions().ReplicaSets(namespace).Delete(replicaSet.Name,
+			&api.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+
+	for _, pod := range pods.Items {
+		if err := client.Pods(namespace).Delete(pod.Name, &api.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Successfully deleted %s deployment from %s namespace", name, namespace)
+
+	return nil
+}
+
+// DeleteDeploymentServices deletes services related to deployment with given name in given
+// namespace.
+func DeleteDeploymentServices(client k8sClient.Interface, namespace, name string) error {
+	log.Printf("Deleting services related to %s deployment from %s namespace", name, namespace)
+
+	deployment, err := client.Extensions().Deployments(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	labelSelector, err := unversioned.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	services, err := getServicesForDeletion(client, labelSelector, namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if err := client.Services(namespace).Delete(service.Name); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Successfully deleted services related to %s deployment from %s namespace",
+		name, namespace)
+
+	return nil
+}
+
+// UpdateReplicasCount updates number of replicas in Deployment based on Deployment Spec
+func UpdateReplicasCount(client k8sClient.Interface, namespace, name string,
+	deploymentSpec *DeploymentSpec) error {
+	log.Printf("Updating replicas count to %d for %s deployment from %s namespace",
+		deploymentSpec.Replicas, name, namespace)
+
+	deployment, err := client.Extensions().Deployments(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	deployment.Spec.Replicas = deploymentSpec.Replicas
+
+	_, err = client.Extensions().Deployments(namespace).Update(deployment)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Successfully updated replicas count to %d for %s deployment from %s namespace",
+		deploymentSpec.Replicas, name, namespace)
+
+	return nil
+}
+
+func getDeploymentDetail(deployment *extensions.Deployment, replicaSets []extensions.ReplicaSet,
+	pods []api.Pod, services []api.Service, events []api.Event) (*DeploymentDetail, error) {
+
+	ownedReplicaSets, err := getDeploymentReplicaSets(deployment, replicaSets)
+	if err != nil {
+		return nil, err
+	}
+
+	newReplicaSet, err := deploymentutil.FindNewReplicaSet(deployment, ownedReplicaSets)
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentDetail := &DeploymentDetail{
+		ObjectMeta: common.CreateObjectMeta(deployment.ObjectMeta),
+		TypeMeta:   common.CreateTypeMeta(deployment.TypeMeta),
+		Selector:   deployment.Spec.Selector,
+		StatusInfo: DeploymentStatusInfo{
+			Replicas:    deployment.Status.Replicas,
+			Updated:     deployment.Status.UpdatedReplicas,
+			Available:   deployment.Status.AvailableReplicas,
+			Unavailable: deployment.Status.UnavailableReplicas,
+		},
+		Strategy:             deployment.Spec.Strategy.Type,
+		MinReadySeconds:      deployment.Spec.MinReadySeconds,
+		RevisionHistoryLimit: deployment.Spec.RevisionHistoryLimit,
+		OldReplicaSets:       make([]ReplicaSetRevision, 0),
+		ServiceList:          resourceService.ServiceList{Services: make([]resourceService.Service, 0)},
+		Events:               event.CreateEvents(events, deployment.Namespace),
+	}
+
+	if rollingUpdate := deployment.Spec.Strategy.RollingUpdate; rollingUpdate != nil {
+		deploymentDetail.RollingUpdateStrategy = &RollingUpdateStrategy{
+			MaxSurge:       rollingUpdate.MaxSurge,
+			MaxUnavailable: rollingUpdate.MaxUnavailable,
+		}
+	}
+
+	for i := range ownedReplicaSets {
+		replicaSet := &ownedReplicaSets[i]
+		revision, err := toReplicaSetRevision(replicaSet, pods)
+		if err != nil {
+			return nil, err
+		}
+
+		if newReplicaSet != nil && replicaSet.Name == newReplicaSet.Name {
+			deploymentDetail.NewReplicaSet = revision
+		} else {
+			deploymentDetail.OldReplicaSets = append(deploymentDetail.OldReplicaSets, *revision)
+		}
+	}
+	sort.Sort(ReplicaSetRevisionSorter(deploymentDetail.OldReplicaSets))
+
+	for _, service := range getMatchingServices(services, deployment) {
+		deploymentDetail.ServiceList.Services = append(deploymentDetail.ServiceList.Services,
+			resourceService.ToService(&service))
+	}
+
+	return deploymentDetail, nil
+}
+
+// Returns replica sets from the namespace of the given deployment that are targeted by its
+// selector.
+func getDeploymentReplicaSets(deployment *extensions.Deployment,
+	replicaSets []extensions.ReplicaSet) ([]extensions.ReplicaSet, error) {
+
+	selector, err := unversioned.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]extensions.ReplicaSet, 0)
+	for _, replicaSet := range replicaSets {
+		if replicaSet.Namespace == deployment.Namespace &&
+			selector.Matches(labels.Set(replicaSet.Labels)) {
+			result = append(result, replicaSet)
+		}
+	}
+	return result, nil
+}
+
+// Returns presentation layer view of the given replica set together with its revision.
+func toReplicaSetRevision(replicaSet *extensions.ReplicaSet,
+	pods []api.Pod) (*ReplicaSetRevision, error) {
+
+	revision, err := deploymentutil.Revision(replicaSet)
+	if err != nil {
+		return nil, err
+	}
+
+	matchingPods := common.GetMatchingPods(replicaSet.Spec.Selector, replicaSet.Namespace, pods)
+	podInfo := common.GetPodInfo(replicaSet.Status.Replicas, replicaSet.Spec.Replicas,
+		matchingPods)
+
+	return &ReplicaSetRevision{
+		Revision:   revision,
+		ReplicaSet: replicaset.ToReplicaSet(replicaSet, &podInfo),
+	}, nil
+}
+
+// Returns services from the namespace of the given deployment that target its pods.
+func getMatchingServices(services []api.Service,
+	deployment *extensions.Deployment) []api.Service {
+
+	var matchingServices []api.Service
+	for _, service := range services {
+		if service.ObjectMeta.Namespace == deployment.ObjectMeta.Namespace &&
+			common.IsLabelSelectorMatching(service.Spec.Selector, deployment.Spec.Template.Labels) {
+
+			matchingServices = append(matchingServices, service)
+		}
+	}
+	return matchingServices
+}
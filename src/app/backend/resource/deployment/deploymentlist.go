@@ -18,7 +18,6 @@ import (
 	"log"
 
 	"github.com/kubernetes/dashboard/resource/common"
-	"github.com/kubernetes/dashboard/resource/replicationcontroller"
 	"k8s.io/kubernetes/pkg/api"
 	k8serrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/apis/extensions"
@@ -27,7 +26,9 @@ import (
 
 // ReplicationSetList contains a list of Deployments in the cluster.
 type DeploymentList struct {
-	// Unordered list of Deployments.
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Deployments.
 	Deployments []Deployment `json:"deployments"`
 }
 
@@ -45,25 +46,27 @@ type Deployment struct {
 	ContainerImages []string `json:"containerImages"`
 }
 
-// GetDeploymentList returns a list of all Deployments in the cluster.
-func GetDeploymentList(client client.Interface) (*DeploymentList, error) {
-	log.Printf("Getting list of all deployments in the cluster")
+// GetDeploymentList returns a list of Deployments in the given namespace, or in all namespaces
+// when it is api.NamespaceAll.
+func GetDeploymentList(client client.Interface, namespace string,
+	dsQuery *common.DataSelectQuery) (*DeploymentList, error) {
+	log.Printf("Getting list of deployments in %s", common.DescribeNamespace(namespace))
 
 	channels := &common.ResourceChannels{
-		DeploymentList: common.GetDeploymentListChannel(client.Extensions(), 1),
-		ServiceList:    common.GetServiceListChannel(client, 1),
-		PodList:        common.GetPodListChannel(client, 1),
-		EventList:      common.GetEventListChannel(client, 1),
+		DeploymentList: common.GetDeploymentListChannel(client.Extensions(), namespace, 1),
+		ServiceList:    common.GetServiceListChannel(client, namespace, 1),
+		PodList:        common.GetPodListChannel(client, namespace, 1),
+		EventList:      common.GetEventListChannel(client, namespace, 1),
 		NodeList:       common.GetNodeListChannel(client, 1),
 	}
 
-	return GetDeploymentListFromChannels(channels)
+	return GetDeploymentListFromChannels(channels, dsQuery)
 }
 
 // GetDeploymentList returns a list of all Deployments in the cluster
 // reading required resource list once from the channels.
-func GetDeploymentListFromChannels(channels *common.ResourceChannels) (
-	*DeploymentList, error) {
+func GetDeploymentListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*DeploymentList, error) {
 
 	deployments := <-channels.DeploymentList.List
 	if err := <-channels.DeploymentList.Error; err != nil {
@@ -97,14 +100,16 @@ func GetDeploymentListFromChannels(channels *common.ResourceChannels) (
 	}
 
 	return getDeploymentList(deployments.Items, services.Items, pods.Items, events.Items,
-		nodes.Items), nil
+		nodes.Items, dsQuery), nil
 }
 
 func getDeploymentList(deployments []extensions.Deployment,
 	services []api.Service, pods []api.Pod, events []api.Event,
-	nodes []api.Node) *DeploymentList {
+	nodes []api.Node, dsQuery *common.DataSelectQuery) *DeploymentList {
 
+	deployments, listMeta := selectDeployments(deployments, dsQuery)
 	deploymentList := &DeploymentList{
+		ListMeta:    listMeta,
 		Deployments: make([]Deployment, 0),
 	}
 
@@ -118,10 +123,38 @@ func getDeploymentList(deployments []extensions.Deployment,
 			Deployment{
 				ObjectMeta:      common.CreateObjectMeta(deployment.ObjectMeta),
 				TypeMeta:        common.CreateTypeMeta(deployment.TypeMeta),
-				ContainerImages: replicationcontroller.GetContainerImages(&deployment.Spec.Template.Spec),
+				ContainerImages: common.GetContainerImages(&deployment.Spec.Template.Spec),
 				Pods:            podInfo,
 			})
 	}
 
 	return deploymentList
 }
+
+// Deployment list item that data select queries are applied to.
+type deploymentCell extensions.Deployment
+
+func (d deploymentCell) GetObjectMeta() api.ObjectMeta {
+	return d.ObjectMeta
+}
+
+func (d deploymentCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	return nil
+}
+
+// Returns Deployments selected by the query together with metadata of the whole filtered list.
+func selectDeployments(deployments []extensions.Deployment, dsQuery *common.DataSelectQuery) (
+	[]extensions.Deployment, common.ListMeta) {
+
+	cells := make([]common.DataCell, len(deployments))
+	for i := range deployments {
+		cells[i] = deploymentCell(deployments[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]extensions.Deployment, len(cells))
+	for i := range cells {
+		result[i] = extensions.Deployment(cells[i].(deploymentCell))
+	}
+	return result, listMeta
+}
@@ -15,27 +15,62 @@
 package event
 
 import (
+	"log"
+	"sort"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/types"
 )
 
 // Events response structure.
 type Events struct {
-	// Namespace.
+	// Namespace. Empty for events from all namespaces.
 	Namespace string `json:"namespace"`
 
 	// List of events from given namespace.
 	Events []Event `json:"events"`
 }
 
+// EventQuery describes which events are listed. Empty fields match all events.
+type EventQuery struct {
+	// Kind of the object involved in the event, e.g., Pod.
+	ObjectKind string
+
+	// Name of the object involved in the event.
+	ObjectName string
+
+	// Type of the event: Normal or Warning.
+	Type string
+
+	// Short, machine understandable reason of the event, e.g., FailedScheduling.
+	Reason string
+
+	// Only events that last occurred at or after this time are listed, when set.
+	Since *unversioned.Time
+
+	// Only events that first occurred at or before this time are listed, when set.
+	Until *unversioned.Time
+}
+
 // Event is a single event representation.
 type Event struct {
 	// A human-readable description of the status of related object.
 	Message string `json:"message"`
 
+	// Kind of the object involved in the event, e.g., Pod.
+	ObjectKind string `json:"objectKind"`
+
+	// Name of the object involved in the event.
+	ObjectName string `json:"objectName"`
+
+	// Namespace of the object involved in the event.
+	ObjectNamespace string `json:"objectNamespace"`
+
 	// Component from which the event is generated.
 	SourceComponent string `json:"sourceComponent"`
 
@@ -74,11 +109,10 @@ type Event struct {
 var FailedReasonPartials = []string{"failed", "err", "exceeded", "invalid", "unhealthy",
 	"mismatch", "insufficient", "conflict", "outof", "nil"}
 
-// GetPodsEventWarnings returns warning pod events by filtering out events targeting only given pods
+// GetPodsEventWarnings returns warning pod events by filtering out events targeting only given pods.
+// Warnings that repeat with the same reason and message for many pods are returned once.
 // TODO(floreks) : Import and use Set instead of custom function to get rid of duplicates
 func GetPodsEventWarnings(events []api.Event, pods []api.Pod) []Event {
-	result := make([]Event, 0)
-
 	// Filter out only warning events
 	events = getWarningEvents(events)
 	failedPods := make([]api.Pod, 0)
@@ -94,15 +128,103 @@ func GetPodsEventWarnings(events []api.Event, pods []api.Pod) []Event {
 	events = FilterEventsByPodsUID(events, failedPods)
 	events = removeDuplicates(events)
 
-	for _, event := range events {
-		result = append(result, Event{
-			Message: event.Message,
-			Reason:  event.Reason,
-			Type:    event.Type,
-		})
+	return AppendEvents(events, Events{Events: make([]Event, 0)}).Events
+}
+
+// GetEventList returns events from the given namespace, or from all namespaces when it is
+// api.NamespaceAll, that match the query. Most recent events come first.
+func GetEventList(client client.EventNamespacer, namespace string, query EventQuery) (*Events,
+	error) {
+	log.Printf("Getting list of events in %s", describeNamespace(namespace))
+
+	fieldSet := fields.Set{}
+	if len(query.ObjectKind) > 0 {
+		fieldSet["involvedObject.kind"] = query.ObjectKind
+	}
+	if len(query.ObjectName) > 0 {
+		fieldSet["involvedObject.name"] = query.ObjectName
+	}
+	if len(query.Reason) > 0 {
+		fieldSet["reason"] = query.Reason
 	}
 
-	return result
+	list, err := client.Events(namespace).List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fields.SelectorFromSet(fieldSet),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := list.Items
+	if !IsTypeFilled(events) {
+		events = FillEventsType(events)
+	}
+	events = filterEventsByType(events, query.Type)
+	events = filterEventsByTime(events, query.Since, query.Until)
+	sort.Stable(eventsByLastSeen(events))
+
+	result := AppendEvents(events, Events{
+		Namespace: namespace,
+		Events:    make([]Event, 0),
+	})
+	return &result, nil
+}
+
+// GetObjectEvents returns events that involve the object of the given kind and name in the given
+// namespace.
+func GetObjectEvents(client client.EventNamespacer, namespace, kind, name string) ([]api.Event,
+	error) {
+
+	fieldSelector, err := fields.ParseSelector("involvedObject.kind=" + kind +
+		",involvedObject.name=" + name)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := client.Events(namespace).List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fieldSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// CreateEvents returns events representation of the given events from the given namespace. Event
+// types are filled in when the apiserver does not provide them.
+func CreateEvents(events []api.Event, namespace string) Events {
+	if !IsTypeFilled(events) {
+		events = FillEventsType(events)
+	}
+
+	return AppendEvents(events, Events{
+		Namespace: namespace,
+		Events:    make([]Event, 0),
+	})
+}
+
+// AppendEvents appends events from source slice to target events representation.
+func AppendEvents(source []api.Event, target Events) Events {
+	for _, event := range source {
+		target.Events = append(target.Events, Event{
+			Message:         event.Message,
+			ObjectKind:      event.InvolvedObject.Kind,
+			ObjectName:      event.InvolvedObject.Name,
+			ObjectNamespace: event.InvolvedObject.Namespace,
+			SourceComponent: event.Source.Component,
+			SourceHost:      event.Source.Host,
+			SubObject:       event.InvolvedObject.FieldPath,
+			Count:           event.Count,
+			FirstSeen:       event.FirstTimestamp,
+			LastSeen:        event.LastTimestamp,
+			Reason:          event.Reason,
+			Type:            event.Type,
+		})
+	}
+	return target
 }
 
 // FilterEventsByPodsUID returns filtered list of event objects.
@@ -155,6 +277,47 @@ func filterEventsByType(events []api.Event, eventType string) []api.Event {
 	return result
 }
 
+// Filters kubernetes API event objects that occurred in the given time window. Nil bounds are
+// not checked.
+func filterEventsByTime(events []api.Event, since, until *unversioned.Time) []api.Event {
+	result := make([]api.Event, 0)
+	for _, event := range events {
+		if since != nil && event.LastTimestamp.Before(*since) {
+			continue
+		}
+		if until != nil && until.Before(event.FirstTimestamp) {
+			continue
+		}
+		result = append(result, event)
+	}
+
+	return result
+}
+
+// Sorts kubernetes API event objects from the most recent ones.
+type eventsByLastSeen []api.Event
+
+func (e eventsByLastSeen) Len() int {
+	return len(e)
+}
+
+func (e eventsByLastSeen) Swap(i, j int) {
+	e[i], e[j] = e[j], e[i]
+}
+
+func (e eventsByLastSeen) Less(i, j int) bool {
+	return e[j].LastTimestamp.Before(e[i].LastTimestamp)
+}
+
+// Returns human readable description of the namespace for logs. Same as
+// common.DescribeNamespace, which cannot be imported here because of a dependency cycle.
+func describeNamespace(namespace string) string {
+	if namespace == api.NamespaceAll {
+		return "all namespaces"
+	}
+	return namespace + " namespace"
+}
+
 // IsTypeFilled returns true if all given events type is filled, false otherwise.
 // This is needed as some older versions of kubernetes do not have Type property filled.
 func IsTypeFilled(events []api.Event) bool {
@@ -196,14 +359,15 @@ func FillEventsType(events []api.Event) []api.Event {
 	return events
 }
 
-// Removes duplicate strings from the slice
+// Removes events with duplicate reason and message from the slice
 func removeDuplicates(slice []api.Event) []api.Event {
-	visited := make(map[string]bool, 0)
+	visited := make(map[[2]string]bool, 0)
 	result := make([]api.Event, 0)
 
 	for _, elem := range slice {
-		if !visited[elem.Reason] {
-			visited[elem.Reason] = true
+		key := [2]string{elem.Reason, elem.Message}
+		if !visited[key] {
+			visited[key] = true
 			result = append(result, elem)
 		}
 	}
@@ -0,0 +1,235 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/service"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// IngressBackendStatus tells whether traffic routed to a backend can reach any pod.
+type IngressBackendStatus string
+
+const (
+	// BackendOk means that the backend service exposes the port and has ready endpoints.
+	BackendOk IngressBackendStatus = "Ok"
+
+	// BackendServiceNotFound means that there is no service with the name of the backend.
+	BackendServiceNotFound IngressBackendStatus = "ServiceNotFound"
+
+	// BackendPortNotFound means that the backend service does not expose the port of the backend.
+	BackendPortNotFound IngressBackendStatus = "PortNotFound"
+
+	// BackendNoReadyEndpoints means that no ready endpoint receives traffic of the backend.
+	BackendNoReadyEndpoints IngressBackendStatus = "NoReadyEndpoints"
+)
+
+// IngressDetail represents detailed information about an Ingress.
+type IngressDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// IP addresses or host names of load balancers that serve the Ingress.
+	Addresses []string `json:"addresses"`
+
+	// TLS configuration of the Ingress. Each entry names the secret used to terminate TLS for its
+	// hosts.
+	TLS []extensions.IngressTLS `json:"tls"`
+
+	// Backend that receives traffic which matches no rule. Nil when it is left to the Ingress
+	// controller.
+	DefaultBackend *IngressBackend `json:"defaultBackend"`
+
+	// Rules of the Ingress, one per host and path.
+	Rules []IngressRule `json:"rules"`
+}
+
+// IngressRule routes traffic for a host and path to a backend.
+type IngressRule struct {
+	// Host the rule applies to. Empty when it applies to all hosts.
+	Host string `json:"host"`
+
+	// Path the rule applies to. Empty when it applies to all paths.
+	Path string `json:"path"`
+
+	// Backend that receives the traffic. Nil when the rule has no paths and the traffic goes to
+	// the default backend.
+	Backend *IngressBackend `json:"backend"`
+}
+
+// IngressBackend is a service port that receives traffic of an Ingress, resolved to the
+// endpoints of the service.
+type IngressBackend struct {
+	// Name of the referenced service.
+	ServiceName string `json:"serviceName"`
+
+	// Referenced port of the service, either its number or its name.
+	ServicePort intstr.IntOrString `json:"servicePort"`
+
+	// Whether traffic routed to the backend can reach any pod.
+	Status IngressBackendStatus `json:"status"`
+
+	// Ready endpoints of the service that receive the traffic on the referenced port.
+	Endpoints []service.ServiceEndpoint `json:"endpoints"`
+}
+
+// GetIngressDetail returns detailed information about the given ingress in the given namespace,
+// with its backends resolved to services and their ready endpoints.
+func GetIngressDetail(client client.Interface, namespace, name string) (*IngressDetail, error) {
+	log.Printf("Getting details of %s ingress in %s namespace", name, namespace)
+
+	channels := &common.ResourceChannels{
+		ServiceList:  common.GetServiceListChannel(client, namespace, 1),
+		EndpointList: common.GetEndpointListChannel(client, namespace, 1),
+	}
+
+	ingress, err := client.Extensions().Ingress(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	services := <-channels.ServiceList.List
+	if err := <-channels.ServiceList.Error; err != nil {
+		return nil, err
+	}
+
+	endpoints := <-channels.EndpointList.List
+	if err := <-channels.EndpointList.Error; err != nil {
+		return nil, err
+	}
+
+	return getIngressDetail(ingress, services.Items, endpoints.Items), nil
+}
+
+func getIngressDetail(ingress *extensions.Ingress, services []api.Service,
+	endpoints []api.Endpoints) *IngressDetail {
+
+	detail := &IngressDetail{
+		ObjectMeta: common.CreateObjectMeta(ingress.ObjectMeta),
+		TypeMeta:   common.CreateTypeMeta(ingress.TypeMeta),
+		Addresses:  getAddresses(ingress),
+		TLS:        ingress.Spec.TLS,
+		Rules:      make([]IngressRule, 0),
+	}
+
+	if ingress.Spec.Backend != nil {
+		backend := resolveBackend(*ingress.Spec.Backend, services, endpoints)
+		detail.DefaultBackend = &backend
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil || len(rule.HTTP.Paths) == 0 {
+			detail.Rules = append(detail.Rules, IngressRule{Host: rule.Host})
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			backend := resolveBackend(path.Backend, services, endpoints)
+			detail.Rules = append(detail.Rules, IngressRule{
+				Host:    rule.Host,
+				Path:    path.Path,
+				Backend: &backend,
+			})
+		}
+	}
+
+	return detail
+}
+
+// Resolves the backend to the service port it references and the ready endpoints of the service
+// on that port.
+func resolveBackend(backend extensions.IngressBackend, services []api.Service,
+	endpoints []api.Endpoints) IngressBackend {
+
+	result := IngressBackend{
+		ServiceName: backend.ServiceName,
+		ServicePort: backend.ServicePort,
+		Endpoints:   make([]service.ServiceEndpoint, 0),
+	}
+
+	backendService := findService(services, backend.ServiceName)
+	if backendService == nil {
+		result.Status = BackendServiceNotFound
+		return result
+	}
+
+	servicePort := findServicePort(backendService, backend.ServicePort)
+	if servicePort == nil {
+		result.Status = BackendPortNotFound
+		return result
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Name == backendService.Name {
+			result.Endpoints = getReadyEndpoints(endpoint, servicePort.Name)
+			break
+		}
+	}
+
+	if len(result.Endpoints) == 0 {
+		result.Status = BackendNoReadyEndpoints
+	} else {
+		result.Status = BackendOk
+	}
+	return result
+}
+
+func findService(services []api.Service, name string) *api.Service {
+	for i := range services {
+		if services[i].Name == name {
+			return &services[i]
+		}
+	}
+	return nil
+}
+
+// Returns the port of the service referenced either by its number or by its name.
+func findServicePort(svc *api.Service, port intstr.IntOrString) *api.ServicePort {
+	for i := range svc.Spec.Ports {
+		servicePort := &svc.Spec.Ports[i]
+		if port.Type == intstr.Int && servicePort.Port == port.IntValue() ||
+			port.Type == intstr.String && servicePort.Name == port.StrVal {
+			return servicePort
+		}
+	}
+	return nil
+}
+
+// Returns ready addresses of the endpoints together with the endpoint port that corresponds to
+// the service port of the given name.
+func getReadyEndpoints(endpoints api.Endpoints, portName string) []service.ServiceEndpoint {
+	result := make([]service.ServiceEndpoint, 0)
+	for _, subset := range endpoints.Subsets {
+		for _, port := range subset.Ports {
+			if port.Name != portName {
+				continue
+			}
+			for _, address := range subset.Addresses {
+				result = append(result, service.ServiceEndpoint{
+					Host:      address.IP,
+					Ports:     []api.EndpointPort{port},
+					Ready:     true,
+					TargetRef: address.TargetRef,
+				})
+			}
+		}
+	}
+	return result
+}
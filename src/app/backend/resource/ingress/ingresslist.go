@@ -0,0 +1,155 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// IngressList contains a list of Ingresses in the cluster.
+type IngressList struct {
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Ingresses.
+	Ingresses []Ingress `json:"ingresses"`
+}
+
+// Ingress is a presentation layer view of Kubernetes Ingress resource.
+type Ingress struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Hosts that the rules of the Ingress apply to. Rules that apply to all hosts are not listed.
+	Hosts []string `json:"hosts"`
+
+	// IP addresses or host names of load balancers that serve the Ingress.
+	Addresses []string `json:"addresses"`
+}
+
+// GetIngressList returns a list of Ingresses in the given namespace, or in all namespaces when it
+// is api.NamespaceAll.
+func GetIngressList(client client.Interface, namespace string,
+	dsQuery *common.DataSelectQuery) (*IngressList, error) {
+	log.Printf("Getting list of ingresses in %s", common.DescribeNamespace(namespace))
+
+	channels := &common.ResourceChannels{
+		IngressList: common.GetIngressListChannel(client.Extensions(), namespace, 1),
+	}
+
+	return GetIngressListFromChannels(channels, dsQuery)
+}
+
+// GetIngressListFromChannels returns a list of all Ingresses in the cluster reading required
+// resource list once from the channels.
+func GetIngressListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*IngressList, error) {
+
+	ingresses := <-channels.IngressList.List
+	if err := <-channels.IngressList.Error; err != nil {
+		statusErr, ok := err.(*k8serrors.StatusError)
+		if ok && statusErr.ErrStatus.Reason == "NotFound" {
+			// NotFound - this means that the server does not support Ingress objects, which is
+			// fine.
+			emptyList := &IngressList{
+				Ingresses: make([]Ingress, 0),
+			}
+			return emptyList, nil
+		}
+		return nil, err
+	}
+
+	selectedIngresses, listMeta := selectIngresses(ingresses.Items, dsQuery)
+	ingressList := &IngressList{
+		ListMeta:  listMeta,
+		Ingresses: make([]Ingress, 0),
+	}
+	for _, ingress := range selectedIngresses {
+		ingressList.Ingresses = append(ingressList.Ingresses, ToIngress(&ingress))
+	}
+
+	return ingressList, nil
+}
+
+// ToIngress returns presentation layer view of the given Ingress.
+func ToIngress(ingress *extensions.Ingress) Ingress {
+	return Ingress{
+		ObjectMeta: common.CreateObjectMeta(ingress.ObjectMeta),
+		TypeMeta:   common.CreateTypeMeta(ingress.TypeMeta),
+		Hosts:      getHosts(ingress),
+		Addresses:  getAddresses(ingress),
+	}
+}
+
+// Returns distinct hosts of the rules of the ingress in the order they are defined.
+func getHosts(ingress *extensions.Ingress) []string {
+	hosts := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == "" || seen[rule.Host] {
+			continue
+		}
+		seen[rule.Host] = true
+		hosts = append(hosts, rule.Host)
+	}
+	return hosts
+}
+
+// Returns IP addresses or, when they are not known, host names of the load balancers of the
+// ingress.
+func getAddresses(ingress *extensions.Ingress) []string {
+	addresses := make([]string, 0)
+	for _, lbIngress := range ingress.Status.LoadBalancer.Ingress {
+		if lbIngress.IP != "" {
+			addresses = append(addresses, lbIngress.IP)
+		} else if lbIngress.Hostname != "" {
+			addresses = append(addresses, lbIngress.Hostname)
+		}
+	}
+	return addresses
+}
+
+// Ingress list item that data select queries are applied to.
+type ingressCell extensions.Ingress
+
+func (i ingressCell) GetObjectMeta() api.ObjectMeta {
+	return i.ObjectMeta
+}
+
+func (i ingressCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	return nil
+}
+
+// Returns Ingresses selected by the query together with metadata of the whole filtered list.
+func selectIngresses(ingresses []extensions.Ingress,
+	dsQuery *common.DataSelectQuery) ([]extensions.Ingress, common.ListMeta) {
+
+	cells := make([]common.DataCell, len(ingresses))
+	for i := range ingresses {
+		cells[i] = ingressCell(ingresses[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]extensions.Ingress, len(cells))
+	for i := range cells {
+		result[i] = extensions.Ingress(cells[i].(ingressCell))
+	}
+	return result, listMeta
+}
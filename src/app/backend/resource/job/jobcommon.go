@@ -0,0 +1,89 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+)
+
+// JobStatus is a summary of the state of a Job.
+type JobStatus string
+
+const (
+	// JobRunning means that the Job has neither completed nor failed yet.
+	JobRunning JobStatus = "Running"
+
+	// JobComplete means that the required number of pods of the Job finished successfully.
+	JobComplete JobStatus = "Complete"
+
+	// JobFailed means that the Job failed, e.g., it exceeded its active deadline.
+	JobFailed JobStatus = "Failed"
+)
+
+// Returns status of the job based on its conditions.
+func getJobStatus(job extensions.Job) JobStatus {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != api.ConditionTrue {
+			continue
+		}
+		if condition.Type == extensions.JobComplete {
+			return JobComplete
+		}
+		if condition.Type == extensions.JobFailed {
+			return JobFailed
+		}
+	}
+	return JobRunning
+}
+
+// Returns aggregate information about the given pods of the job. Desired number of pods is the
+// parallelism of the job, which defaults to 1.
+func getPodInfo(job *extensions.Job, pods []api.Pod) common.PodInfo {
+	parallelism := 1
+	if job.Spec.Parallelism != nil {
+		parallelism = *job.Spec.Parallelism
+	}
+	return common.GetPodInfo(job.Status.Active, parallelism, pods)
+}
+
+// Returns the job with the given name together with its pods.
+func getRawJobWithPods(client client.Interface, namespace, name string) (*extensions.Job,
+	[]api.Pod, error) {
+
+	job, err := client.Batch().Jobs(namespace).Get(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	labelSelector, err := unversioned.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pods, err := client.Pods(namespace).List(api.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return job, pods.Items, nil
+}
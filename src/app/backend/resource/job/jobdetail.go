@@ -0,0 +1,149 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/pod"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// JobDetail represents detailed information about a Job.
+type JobDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Label selector of the Job.
+	Selector *unversioned.LabelSelector `json:"selector"`
+
+	// Container image list of the pod template specified by this Job.
+	ContainerImages []string `json:"containerImages"`
+
+	// Number of successfully finished pods needed for the Job to complete. Nil when any pod
+	// finishing successfully completes the Job.
+	Completions *int `json:"completions"`
+
+	// Maximum number of pods of the Job that run at the same time.
+	Parallelism *int `json:"parallelism"`
+
+	// Duration in seconds after which the Job is terminated. Nil when it can run forever.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds"`
+
+	// Summary of the state of the Job.
+	Status JobStatus `json:"status"`
+
+	// Number of pods of the Job that are running.
+	Active int `json:"active"`
+
+	// Number of pods of the Job that finished successfully.
+	Succeeded int `json:"succeeded"`
+
+	// Number of pods of the Job that failed.
+	Failed int `json:"failed"`
+
+	// Time when the Job was started. Nil when it has not started yet.
+	StartTime *unversioned.Time `json:"startTime"`
+
+	// Time when the Job completed. Nil when it has not completed yet.
+	CompletionTime *unversioned.Time `json:"completionTime"`
+
+	// Latest observed conditions of the Job.
+	Conditions []extensions.JobCondition `json:"conditions"`
+
+	// Aggregate information about pods of this Job.
+	PodInfo common.PodInfo `json:"podInfo"`
+
+	// Detailed information about Pods belonging to this Job.
+	Pods pod.PodList `json:"pods"`
+
+	// Events related to the Job.
+	Events event.Events `json:"events"`
+}
+
+// GetJobDetail returns detailed information about the given job in the given namespace.
+func GetJobDetail(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace, name string) (*JobDetail, error) {
+	log.Printf("Getting details of %s job in %s namespace", name, namespace)
+
+	job, pods, err := getRawJobWithPods(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := event.GetObjectEvents(client, namespace, "Job", name)
+	if err != nil {
+		return nil, err
+	}
+
+	jobDetail := getJobDetail(job, pods, events)
+	jobDetail.Pods = pod.CreatePodList(pods, common.NoDataSelect, heapsterClient)
+
+	return jobDetail, nil
+}
+
+// DeleteJob deletes job with given name in given namespace and its pods.
+func DeleteJob(client k8sClient.Interface, namespace, name string) error {
+	log.Printf("Deleting %s job from %s namespace", name, namespace)
+
+	_, pods, err := getRawJobWithPods(client, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Batch().Jobs(namespace).Delete(name, &api.DeleteOptions{}); err != nil {
+		return err
+	}
+
+	for _, pod := range pods {
+		if err := client.Pods(namespace).Delete(pod.Name, &api.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Successfully deleted %s job from %s namespace", name, namespace)
+
+	return nil
+}
+
+func getJobDetail(job *extensions.Job, pods []api.Pod, events []api.Event) *JobDetail {
+	podInfo := getPodInfo(job, pods)
+	podInfo.Warnings = event.GetPodsEventWarnings(events, pods)
+
+	return &JobDetail{
+		ObjectMeta:            common.CreateObjectMeta(job.ObjectMeta),
+		TypeMeta:              common.CreateTypeMeta(job.TypeMeta),
+		Selector:              job.Spec.Selector,
+		ContainerImages:       common.GetContainerImages(&job.Spec.Template.Spec),
+		Completions:           job.Spec.Completions,
+		Parallelism:           job.Spec.Parallelism,
+		ActiveDeadlineSeconds: job.Spec.ActiveDeadlineSeconds,
+		Status:                getJobStatus(*job),
+		Active:                job.Status.Active,
+		Succeeded:             job.Status.Succeeded,
+		Failed:                job.Status.Failed,
+		StartTime:             job.Status.StartTime,
+		CompletionTime:        job.Status.CompletionTime,
+		Conditions:            job.Status.Conditions,
+		PodInfo:               podInfo,
+		Events:                event.CreateEvents(events, job.Namespace),
+	}
+}
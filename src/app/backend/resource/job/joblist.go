@@ -0,0 +1,176 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// JobList contains a list of Jobs in the cluster.
+type JobList struct {
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Jobs.
+	Jobs []Job `json:"jobs"`
+}
+
+// Job is a presentation layer view of Kubernetes Job resource. This means it is Job plus
+// additional augumented data we can get from other sources (like warnings of its pods).
+type Job struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Aggregate information about pods belonging to this Job.
+	Pods common.PodInfo `json:"pods"`
+
+	// Container images of the Job.
+	ContainerImages []string `json:"containerImages"`
+
+	// Number of successfully finished pods needed for the Job to complete. Nil when any pod
+	// finishing successfully completes the Job.
+	Completions *int `json:"completions"`
+
+	// Maximum number of pods of the Job that run at the same time.
+	Parallelism *int `json:"parallelism"`
+
+	// Number of pods of the Job that are running.
+	Active int `json:"active"`
+
+	// Number of pods of the Job that finished successfully.
+	Succeeded int `json:"succeeded"`
+
+	// Number of pods of the Job that failed.
+	Failed int `json:"failed"`
+}
+
+// GetJobList returns a list of Jobs in the given namespace, or in all namespaces when it is
+// api.NamespaceAll.
+func GetJobList(client client.Interface, namespace string,
+	dsQuery *common.DataSelectQuery) (*JobList, error) {
+	log.Printf("Getting list of jobs in %s", common.DescribeNamespace(namespace))
+
+	channels := &common.ResourceChannels{
+		JobList:   common.GetJobListChannel(client.Batch(), namespace, 1),
+		PodList:   common.GetPodListChannel(client, namespace, 1),
+		EventList: common.GetEventListChannel(client, namespace, 1),
+	}
+
+	return GetJobListFromChannels(channels, dsQuery)
+}
+
+// GetJobListFromChannels returns a list of all Jobs in the cluster reading required resource
+// list once from the channels.
+func GetJobListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*JobList, error) {
+
+	jobs := <-channels.JobList.List
+	if err := <-channels.JobList.Error; err != nil {
+		statusErr, ok := err.(*k8serrors.StatusError)
+		if ok && statusErr.ErrStatus.Reason == "NotFound" {
+			// NotFound - this means that the server does not support Job objects, which is fine.
+			emptyList := &JobList{
+				Jobs: make([]Job, 0),
+			}
+			return emptyList, nil
+		}
+		return nil, err
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	events := <-channels.EventList.List
+	if err := <-channels.EventList.Error; err != nil {
+		return nil, err
+	}
+
+	return getJobList(jobs.Items, pods.Items, events.Items, dsQuery), nil
+}
+
+func getJobList(jobs []extensions.Job, pods []api.Pod, events []api.Event,
+	dsQuery *common.DataSelectQuery) *JobList {
+
+	jobs, listMeta := selectJobs(jobs, dsQuery)
+	jobList := &JobList{
+		ListMeta: listMeta,
+		Jobs:     make([]Job, 0),
+	}
+
+	for _, job := range jobs {
+		matchingPods := common.GetMatchingPods(job.Spec.Selector, job.ObjectMeta.Namespace, pods)
+		podInfo := getPodInfo(&job, matchingPods)
+		podInfo.Warnings = event.GetPodsEventWarnings(events, matchingPods)
+
+		jobList.Jobs = append(jobList.Jobs, ToJob(&job, &podInfo))
+	}
+
+	return jobList
+}
+
+// ToJob returns presentation layer view of the given Job with the given aggregate information
+// about its pods.
+func ToJob(job *extensions.Job, podInfo *common.PodInfo) Job {
+	return Job{
+		ObjectMeta:      common.CreateObjectMeta(job.ObjectMeta),
+		TypeMeta:        common.CreateTypeMeta(job.TypeMeta),
+		ContainerImages: common.GetContainerImages(&job.Spec.Template.Spec),
+		Pods:            *podInfo,
+		Completions:     job.Spec.Completions,
+		Parallelism:     job.Spec.Parallelism,
+		Active:          job.Status.Active,
+		Succeeded:       job.Status.Succeeded,
+		Failed:          job.Status.Failed,
+	}
+}
+
+// Job list item that data select queries are applied to.
+type jobCell extensions.Job
+
+func (j jobCell) GetObjectMeta() api.ObjectMeta {
+	return j.ObjectMeta
+}
+
+func (j jobCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	if name == common.StatusProperty {
+		return common.StdComparableString(getJobStatus(extensions.Job(j)))
+	}
+	return nil
+}
+
+// Returns Jobs selected by the query together with metadata of the whole filtered list.
+func selectJobs(jobs []extensions.Job, dsQuery *common.DataSelectQuery) ([]extensions.Job,
+	common.ListMeta) {
+
+	cells := make([]common.DataCell, len(jobs))
+	for i := range jobs {
+		cells[i] = jobCell(jobs[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]extensions.Job, len(cells))
+	for i := range cells {
+		result[i] = extensions.Job(cells[i].(jobCell))
+	}
+	return result, listMeta
+}
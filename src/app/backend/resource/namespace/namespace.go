@@ -27,6 +27,12 @@ import (
 type NamespaceSpec struct {
 	// Name of the namespace.
 	Name string `json:"name"`
+
+	// Labels to apply to the namespace.
+	Labels map[string]string `json:"labels"`
+
+	// Annotations to apply to the namespace.
+	Annotations map[string]string `json:"annotations"`
 }
 
 // NamespaceList is a list of namespaces in the cluster.
@@ -36,12 +42,14 @@ type NamespaceList struct {
 }
 
 // CreateNamespace creates namespace based on given specification.
-func CreateNamespace(spec *NamespaceSpec, client *client.Client) error {
+func CreateNamespace(spec *NamespaceSpec, client client.Interface) error {
 	log.Printf("Creating namespace %s", spec.Name)
 
 	namespace := &api.Namespace{
 		ObjectMeta: api.ObjectMeta{
-			Name: spec.Name,
+			Name:        spec.Name,
+			Labels:      spec.Labels,
+			Annotations: spec.Annotations,
 		},
 	}
 
@@ -51,7 +59,7 @@ func CreateNamespace(spec *NamespaceSpec, client *client.Client) error {
 }
 
 // GetNamespaceList returns a list of all namespaces in the cluster.
-func GetNamespaceList(client *client.Client) (*NamespaceList, error) {
+func GetNamespaceList(client client.Interface) (*NamespaceList, error) {
 	log.Printf("Getting namespace list")
 
 	list, err := client.Namespaces().List(api.ListOptions{
@@ -71,3 +79,11 @@ func GetNamespaceList(client *client.Client) (*NamespaceList, error) {
 
 	return namespaceList, nil
 }
+
+// DeleteNamespace deletes namespace with the given name. All objects in the namespace are deleted
+// together with it.
+func DeleteNamespace(client client.Interface, name string) error {
+	log.Printf("Deleting namespace %s", name)
+
+	return client.Namespaces().Delete(name)
+}
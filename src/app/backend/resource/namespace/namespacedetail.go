@@ -0,0 +1,258 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// NamespaceDetail is a presentation layer view of Kubernetes Namespace resource. This means it is
+// Namespace plus additional augumented data we can get from other sources (like quotas and
+// objects in it).
+type NamespaceDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Phase of the namespace: Active or Terminating.
+	Phase api.NamespacePhase `json:"phase"`
+
+	// Resource quotas of the namespace.
+	ResourceQuotas []ResourceQuota `json:"resourceQuotas"`
+
+	// Limit ranges of the namespace.
+	LimitRanges []LimitRange `json:"limitRanges"`
+
+	// Number of objects of each kind in the namespace. These objects are deleted together with
+	// the namespace.
+	ObjectCounts ObjectCounts `json:"objectCounts"`
+
+	// Events in the namespace, newest first.
+	Events event.Events `json:"events"`
+}
+
+// ResourceQuota is a resource quota of a namespace together with its usage.
+type ResourceQuota struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+
+	// Usage of each resource constrained by the quota, sorted by resource name.
+	Resources []ResourceQuotaStatus `json:"resources"`
+}
+
+// ResourceQuotaStatus is usage of a single resource constrained by a quota.
+type ResourceQuotaStatus struct {
+	// Name of the resource, e.g., cpu or pods.
+	Name api.ResourceName `json:"name"`
+
+	// Amount of the resource that is used in the namespace.
+	Used string `json:"used"`
+
+	// Amount of the resource that can be used in the namespace.
+	Hard string `json:"hard"`
+}
+
+// LimitRange is a limit range of a namespace.
+type LimitRange struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+
+	// Limits enforced on pods and containers of the namespace.
+	Limits []api.LimitRangeItem `json:"limits"`
+}
+
+// ObjectCounts is a number of objects of each kind in a namespace.
+type ObjectCounts struct {
+	Pods                   int `json:"pods"`
+	ReplicationControllers int `json:"replicationControllers"`
+	ReplicaSets            int `json:"replicaSets"`
+	Deployments            int `json:"deployments"`
+	Jobs                   int `json:"jobs"`
+	DaemonSets             int `json:"daemonSets"`
+	Services               int `json:"services"`
+	Ingresses              int `json:"ingresses"`
+	PersistentVolumeClaims int `json:"persistentVolumeClaims"`
+	Secrets                int `json:"secrets"`
+}
+
+// GetNamespaceDetail returns detailed information about the namespace with the given name.
+func GetNamespaceDetail(client client.Interface, name string) (*NamespaceDetail, error) {
+	log.Printf("Getting details of %s namespace", name)
+
+	namespace, err := client.Namespaces().Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := &common.ResourceChannels{
+		PodList:                   common.GetPodListChannel(client, name, 1),
+		ReplicationControllerList: common.GetReplicationControllerListChannel(client, name, 1),
+		ReplicaSetList:            common.GetReplicaSetListChannel(client.Extensions(), name, 1),
+		DeploymentList:            common.GetDeploymentListChannel(client.Extensions(), name, 1),
+		JobList:                   common.GetJobListChannel(client.Batch(), name, 1),
+		DaemonSetList:             common.GetDaemonSetListChannel(client.Extensions(), name, 1),
+		ServiceList:               common.GetServiceListChannel(client, name, 1),
+		IngressList:               common.GetIngressListChannel(client.Extensions(), name, 1),
+		PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(client, name, 1),
+	}
+
+	objectCounts, err := getObjectCounts(client, name, channels)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas, err := client.ResourceQuotas(name).List(listEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	limitRanges, err := client.LimitRanges(name).List(listEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := event.GetEventList(client, name, event.EventQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NamespaceDetail{
+		ObjectMeta:     common.CreateObjectMeta(namespace.ObjectMeta),
+		TypeMeta:       common.CreateTypeMeta(namespace.TypeMeta),
+		Phase:          namespace.Status.Phase,
+		ResourceQuotas: toResourceQuotas(quotas.Items),
+		LimitRanges:    toLimitRanges(limitRanges.Items),
+		ObjectCounts:   *objectCounts,
+		Events:         *events,
+	}, nil
+}
+
+var listEverything = api.ListOptions{
+	LabelSelector: labels.Everything(),
+	FieldSelector: fields.Everything(),
+}
+
+// Returns number of objects of each kind in the namespace, reading resource lists once from the
+// channels.
+func getObjectCounts(client client.Interface, namespace string,
+	channels *common.ResourceChannels) (*ObjectCounts, error) {
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	replicationControllers := <-channels.ReplicationControllerList.List
+	if err := <-channels.ReplicationControllerList.Error; err != nil {
+		return nil, err
+	}
+
+	replicaSets := <-channels.ReplicaSetList.List
+	if err := <-channels.ReplicaSetList.Error; err != nil {
+		return nil, err
+	}
+
+	deployments := <-channels.DeploymentList.List
+	if err := <-channels.DeploymentList.Error; err != nil {
+		return nil, err
+	}
+
+	jobs := <-channels.JobList.List
+	if err := <-channels.JobList.Error; err != nil {
+		return nil, err
+	}
+
+	daemonSets := <-channels.DaemonSetList.List
+	if err := <-channels.DaemonSetList.Error; err != nil {
+		return nil, err
+	}
+
+	services := <-channels.ServiceList.List
+	if err := <-channels.ServiceList.Error; err != nil {
+		return nil, err
+	}
+
+	ingresses := <-channels.IngressList.List
+	if err := <-channels.IngressList.Error; err != nil {
+		return nil, err
+	}
+
+	persistentVolumeClaims := <-channels.PersistentVolumeClaimList.List
+	if err := <-channels.PersistentVolumeClaimList.Error; err != nil {
+		return nil, err
+	}
+
+	secrets, err := client.Secrets(namespace).List(listEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectCounts{
+		Pods:                   len(pods.Items),
+		ReplicationControllers: len(replicationControllers.Items),
+		ReplicaSets:            len(replicaSets.Items),
+		Deployments:            len(deployments.Items),
+		Jobs:                   len(jobs.Items),
+		DaemonSets:             len(daemonSets.Items),
+		Services:               len(services.Items),
+		Ingresses:              len(ingresses.Items),
+		PersistentVolumeClaims: len(persistentVolumeClaims.Items),
+		Secrets:                len(secrets.Items),
+	}, nil
+}
+
+func toResourceQuotas(quotas []api.ResourceQuota) []ResourceQuota {
+	result := make([]ResourceQuota, 0)
+	for _, quota := range quotas {
+		resources := make([]ResourceQuotaStatus, 0)
+		for name, hard := range quota.Status.Hard {
+			used := quota.Status.Used[name]
+			resources = append(resources, ResourceQuotaStatus{
+				Name: name,
+				Used: used.String(),
+				Hard: hard.String(),
+			})
+		}
+		sort.Sort(resourceQuotaStatusesByName(resources))
+
+		result = append(result, ResourceQuota{
+			ObjectMeta: common.CreateObjectMeta(quota.ObjectMeta),
+			Resources:  resources,
+		})
+	}
+	return result
+}
+
+type resourceQuotaStatusesByName []ResourceQuotaStatus
+
+func (r resourceQuotaStatusesByName) Len() int           { return len(r) }
+func (r resourceQuotaStatusesByName) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r resourceQuotaStatusesByName) Less(i, j int) bool { return r[i].Name < r[j].Name }
+
+func toLimitRanges(limitRanges []api.LimitRange) []LimitRange {
+	result := make([]LimitRange, 0)
+	for _, limitRange := range limitRanges {
+		result = append(result, LimitRange{
+			ObjectMeta: common.CreateObjectMeta(limitRange.ObjectMeta),
+			Limits:     limitRange.Spec.Limits,
+		})
+	}
+	return result
+}
@@ -0,0 +1,43 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// Returns status of the given condition type of the node. ConditionUnknown is returned when the
+// node does not report such condition.
+func getNodeConditionStatus(node api.Node, conditionType api.NodeConditionType) api.ConditionStatus {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status
+		}
+	}
+	return api.ConditionUnknown
+}
+
+// Returns pods scheduled on the node with the given name that are neither succeeded nor failed,
+// i.e., the ones that use node resources.
+func getNonTerminatedNodePods(nodeName string, pods []api.Pod) []api.Pod {
+	result := make([]api.Pod, 0)
+	for _, pod := range pods {
+		if pod.Spec.NodeName == nodeName && pod.Status.Phase != api.PodSucceeded &&
+			pod.Status.Phase != api.PodFailed {
+			result = append(result, pod)
+		}
+	}
+	return result
+}
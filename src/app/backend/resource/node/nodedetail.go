@@ -0,0 +1,208 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/pod"
+	"k8s.io/kubernetes/pkg/api"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// NodeAllocatedResources describes node allocated resources.
+type NodeAllocatedResources struct {
+	// CPURequests is number of allocated milicores.
+	CPURequests int64 `json:"cpuRequests"`
+
+	// CPURequestsFraction is a fraction of CPU, that is allocated.
+	CPURequestsFraction float64 `json:"cpuRequestsFraction"`
+
+	// CPULimits is defined CPU limit.
+	CPULimits int64 `json:"cpuLimits"`
+
+	// CPULimitsFraction is a fraction of defined CPU limit, can be over 100%, i.e.
+	// overcommitted.
+	CPULimitsFraction float64 `json:"cpuLimitsFraction"`
+
+	// CPUAllocatable is number of milicores that can be allocated by pods on the node.
+	CPUAllocatable int64 `json:"cpuAllocatable"`
+
+	// MemoryRequests is requested memory in bytes.
+	MemoryRequests int64 `json:"memoryRequests"`
+
+	// MemoryRequestsFraction is a fraction of memory, that is allocated.
+	MemoryRequestsFraction float64 `json:"memoryRequestsFraction"`
+
+	// MemoryLimits defines memory limit.
+	MemoryLimits int64 `json:"memoryLimits"`
+
+	// MemoryLimitsFraction is a fraction of defined memory limit, can be over 100%, i.e.
+	// overcommitted.
+	MemoryLimitsFraction float64 `json:"memoryLimitsFraction"`
+
+	// MemoryAllocatable is memory in bytes that can be allocated by pods on the node.
+	MemoryAllocatable int64 `json:"memoryAllocatable"`
+}
+
+// NodeDetail is a presentation layer view of Kubernetes Node resource. This means it is Node plus
+// additional augumented data we can get from other sources.
+type NodeDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// NodePhase is the current lifecycle phase of the node.
+	Phase api.NodePhase `json:"phase"`
+
+	// PodCIDR represents the pod IP range assigned to the node.
+	PodCIDR string `json:"podCIDR"`
+
+	// ID of the node assigned by the cloud provider.
+	ProviderID string `json:"providerID"`
+
+	// Whether new pods can be scheduled on the Node.
+	Unschedulable bool `json:"unschedulable"`
+
+	// Set of ids/uuids to uniquely identify the node.
+	NodeInfo api.NodeSystemInfo `json:"nodeInfo"`
+
+	// Latest observed conditions of the Node.
+	Conditions []api.NodeCondition `json:"conditions"`
+
+	// Addresses reachable to the Node.
+	Addresses []api.NodeAddress `json:"addresses"`
+
+	// Resources requested by and allocatable to the pods on the Node.
+	AllocatedResources NodeAllocatedResources `json:"allocatedResources"`
+
+	// Pods running on the Node.
+	Pods pod.PodList `json:"pods"`
+
+	// Events related to the Node.
+	Events event.Events `json:"events"`
+
+	// Node metrics from Heapster. Nil when they could not be retrieved.
+	Metrics *NodeMetrics `json:"metrics"`
+}
+
+// GetNodeDetail returns detailed information about the given node.
+func GetNodeDetail(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	name string) (*NodeDetail, error) {
+	log.Printf("Getting details of %s node", name)
+
+	node, err := client.Nodes().Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := getNodePods(client, *node)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := event.GetObjectEvents(client, api.NamespaceAll, "Node", node.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := getNodeMetrics(node.Name, heapsterClient)
+	if err != nil {
+		log.Printf("Skipping Heapster metrics because of error: %s\n", err)
+	}
+
+	nodeDetail := &NodeDetail{
+		ObjectMeta:         common.CreateObjectMeta(node.ObjectMeta),
+		TypeMeta:           common.CreateTypeMeta(node.TypeMeta),
+		Phase:              node.Status.Phase,
+		PodCIDR:            node.Spec.PodCIDR,
+		ProviderID:         node.Spec.ProviderID,
+		Unschedulable:      node.Spec.Unschedulable,
+		NodeInfo:           node.Status.NodeInfo,
+		Conditions:         node.Status.Conditions,
+		Addresses:          node.Status.Addresses,
+		AllocatedResources: getNodeAllocatedResources(*node, pods),
+		Pods:               pod.CreatePodList(pods, common.NoDataSelect, heapsterClient),
+		Events:             event.CreateEvents(events, api.NamespaceAll),
+		Metrics:            metrics,
+	}
+
+	return nodeDetail, nil
+}
+
+// Returns non-terminated pods scheduled on the given node.
+func getNodePods(client k8sClient.Interface, node api.Node) ([]api.Pod, error) {
+	fieldSelector, err := fields.ParseSelector(api.PodHostField + "=" + node.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.Pods(api.NamespaceAll).List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fieldSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return getNonTerminatedNodePods(node.Name, pods.Items), nil
+}
+
+// Returns sum of resource requests and limits of the given pods compared to what is allocatable on
+// the node.
+func getNodeAllocatedResources(node api.Node, pods []api.Pod) NodeAllocatedResources {
+	var cpuRequests, cpuLimits, memoryRequests, memoryLimits int64
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			cpuRequests += container.Resources.Requests.Cpu().MilliValue()
+			cpuLimits += container.Resources.Limits.Cpu().MilliValue()
+			memoryRequests += container.Resources.Requests.Memory().Value()
+			memoryLimits += container.Resources.Limits.Memory().Value()
+		}
+	}
+
+	// Nodes that do not report allocatable resources can use their whole capacity.
+	allocatable := node.Status.Allocatable
+	if len(allocatable) == 0 {
+		allocatable = node.Status.Capacity
+	}
+	cpuAllocatable := allocatable.Cpu().MilliValue()
+	memoryAllocatable := allocatable.Memory().Value()
+
+	return NodeAllocatedResources{
+		CPURequests:            cpuRequests,
+		CPURequestsFraction:    getFraction(cpuRequests, cpuAllocatable),
+		CPULimits:              cpuLimits,
+		CPULimitsFraction:      getFraction(cpuLimits, cpuAllocatable),
+		CPUAllocatable:         cpuAllocatable,
+		MemoryRequests:         memoryRequests,
+		MemoryRequestsFraction: getFraction(memoryRequests, memoryAllocatable),
+		MemoryLimits:           memoryLimits,
+		MemoryLimitsFraction:   getFraction(memoryLimits, memoryAllocatable),
+		MemoryAllocatable:      memoryAllocatable,
+	}
+}
+
+// Returns value as a percentage of total, or 0 when total is unknown.
+func getFraction(value, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(value) / float64(total) * 100
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// NodeList contains a list of Nodes in the cluster.
+type NodeList struct {
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Nodes.
+	Nodes []Node `json:"nodes"`
+}
+
+// Node is a presentation layer view of Kubernetes Node resource. This means it is Node plus
+// additional augumented data we can get from other sources (like pods scheduled on it).
+type Node struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Status of the Ready condition of the Node. Unknown when the Node has not reported it.
+	Ready api.ConditionStatus `json:"ready"`
+
+	// Whether new pods can be scheduled on the Node.
+	Unschedulable bool `json:"unschedulable"`
+
+	// Latest observed conditions of the Node.
+	Conditions []api.NodeCondition `json:"conditions"`
+
+	// Addresses reachable to the Node.
+	Addresses []api.NodeAddress `json:"addresses"`
+
+	// Total resources of the Node.
+	Capacity api.ResourceList `json:"capacity"`
+
+	// Resources of the Node that are available for scheduling.
+	Allocatable api.ResourceList `json:"allocatable"`
+
+	// Number of non-terminated Pods running on the Node.
+	PodCount int `json:"podCount"`
+}
+
+// GetNodeList returns a list of all Nodes in the cluster.
+func GetNodeList(client client.Interface, dsQuery *common.DataSelectQuery) (*NodeList, error) {
+	log.Printf("Getting list of all nodes in the cluster")
+
+	channels := &common.ResourceChannels{
+		NodeList: common.GetNodeListChannel(client, 1),
+		PodList:  common.GetPodListChannel(client, api.NamespaceAll, 1),
+	}
+
+	return GetNodeListFromChannels(channels, dsQuery)
+}
+
+// GetNodeListFromChannels returns a list of all Nodes in the cluster reading required resource
+// list once from the channels.
+func GetNodeListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*NodeList, error) {
+	nodes := <-channels.NodeList.List
+	if err := <-channels.NodeList.Error; err != nil {
+		return nil, err
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	return getNodeList(nodes.Items, pods.Items, dsQuery), nil
+}
+
+func getNodeList(nodes []api.Node, pods []api.Pod, dsQuery *common.DataSelectQuery) *NodeList {
+	nodes, listMeta := selectNodes(nodes, dsQuery)
+	nodeList := &NodeList{
+		ListMeta: listMeta,
+		Nodes:    make([]Node, 0),
+	}
+
+	for _, node := range nodes {
+		nodeList.Nodes = append(nodeList.Nodes, Node{
+			ObjectMeta:    common.CreateObjectMeta(node.ObjectMeta),
+			TypeMeta:      common.CreateTypeMeta(node.TypeMeta),
+			Ready:         getNodeConditionStatus(node, api.NodeReady),
+			Unschedulable: node.Spec.Unschedulable,
+			Conditions:    node.Status.Conditions,
+			Addresses:     node.Status.Addresses,
+			Capacity:      node.Status.Capacity,
+			Allocatable:   node.Status.Allocatable,
+			PodCount:      len(getNonTerminatedNodePods(node.Name, pods)),
+		})
+	}
+
+	return nodeList
+}
+
+// Node list item that data select queries are applied to.
+type nodeCell api.Node
+
+func (n nodeCell) GetObjectMeta() api.ObjectMeta {
+	return n.ObjectMeta
+}
+
+func (n nodeCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	if name == common.StatusProperty {
+		return common.StdComparableString(getNodeConditionStatus(api.Node(n), api.NodeReady))
+	}
+	return nil
+}
+
+// Returns Nodes selected by the query together with metadata of the whole filtered list.
+func selectNodes(nodes []api.Node, dsQuery *common.DataSelectQuery) ([]api.Node, common.ListMeta) {
+	cells := make([]common.DataCell, len(nodes))
+	for i := range nodes {
+		cells[i] = nodeCell(nodes[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]api.Node, len(cells))
+	for i := range cells {
+		result[i] = api.Node(cells[i].(nodeCell))
+	}
+	return result, listMeta
+}
@@ -0,0 +1,107 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/pod"
+	heapster "k8s.io/heapster/api/v1/types"
+)
+
+const (
+	cpuUsage    = "cpu-usage"
+	memoryUsage = "memory-usage"
+)
+
+// NodeMetrics is a structure representing node metrics, contains information about CPU and
+// memory usage.
+type NodeMetrics struct {
+	// Most recent measure of CPU usage on all cores in nanoseconds.
+	CpuUsage *uint64 `json:"cpuUsage"`
+	// Node memory usage in bytes.
+	MemoryUsage *uint64 `json:"memoryUsage"`
+	// Timestamped samples of CpuUsage over some short period of history
+	CpuUsageHistory []pod.MetricResult `json:"cpuUsageHistory"`
+	// Timestamped samples of node memory usage over some short period of history
+	MemoryUsageHistory []pod.MetricResult `json:"memoryUsageHistory"`
+}
+
+// Returns metrics of the node with the given name. Returns error in case of errors when talking
+// with heapster.
+func getNodeMetrics(nodeName string, heapsterClient client.HeapsterClient) (*NodeMetrics, error) {
+	log.Printf("Getting metrics of %s node", nodeName)
+
+	cpuMetricResult, err := getNodeMetric(heapsterClient, nodeName, cpuUsage)
+	if err != nil {
+		return nil, err
+	}
+
+	memMetricResult, err := getNodeMetric(heapsterClient, nodeName, memoryUsage)
+	if err != nil {
+		return nil, err
+	}
+
+	return createNodeMetrics(cpuMetricResult, memMetricResult), nil
+}
+
+// Retrieves and deserializes a single metric of the node from Heapster.
+func getNodeMetric(heapsterClient client.HeapsterClient, nodeName, metricName string) (
+	*heapster.MetricResult, error) {
+
+	rawData, err := heapsterClient.Get(
+		fmt.Sprintf("/model/nodes/%s/metrics/%s", nodeName, metricName)).DoRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	metricResult := &heapster.MetricResult{}
+	if err := json.Unmarshal(rawData, metricResult); err != nil {
+		return nil, err
+	}
+	return metricResult, nil
+}
+
+// Creates response structure for API call.
+func createNodeMetrics(cpuMetrics *heapster.MetricResult,
+	memMetrics *heapster.MetricResult) *NodeMetrics {
+
+	result := &NodeMetrics{
+		CpuUsageHistory:    make([]pod.MetricResult, len(cpuMetrics.Metrics)),
+		MemoryUsageHistory: make([]pod.MetricResult, len(memMetrics.Metrics)),
+	}
+
+	if len(cpuMetrics.Metrics) > 0 {
+		result.CpuUsage = &cpuMetrics.Metrics[0].Value
+	}
+	if len(memMetrics.Metrics) > 0 {
+		result.MemoryUsage = &memMetrics.Metrics[0].Value
+	}
+
+	for i, cpuMeasure := range cpuMetrics.Metrics {
+		result.CpuUsageHistory[i].Value = cpuMeasure.Value
+		result.CpuUsageHistory[i].Timestamp = cpuMeasure.Timestamp
+	}
+
+	for i, memMeasure := range memMetrics.Metrics {
+		result.MemoryUsageHistory[i].Value = memMeasure.Value
+		result.MemoryUsageHistory[i].Timestamp = memMeasure.Timestamp
+	}
+
+	return result
+}
@@ -0,0 +1,221 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// WatchSpec describes resources to be watched.
+type WatchSpec struct {
+	// Resource to watch as named in the API paths, e.g., "pods" or "replicationcontrollers".
+	Resource string
+
+	// Namespace to watch or api.NamespaceAll to watch all of them. Ignored for resources that are
+	// not namespaced.
+	Namespace string
+
+	// Only resources with labels matching the selector are watched.
+	LabelSelector labels.Selector
+
+	// Resource version to start watching from. Empty string starts with the current state of
+	// resources, i.e., with an ADDED notification for each of them.
+	ResourceVersion string
+}
+
+// Notification describes a change of a watched resource.
+type Notification struct {
+	// Type of the change: ADDED, MODIFIED or DELETED.
+	Type watch.EventType `json:"type"`
+
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Resource version of the changed object. Watching can be resumed from it.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// Describes how to watch a resource of a single kind.
+type watchedResource struct {
+	// Kind of the watched objects.
+	kind string
+
+	// Starts watching the resource in the given namespace.
+	watch func(client client.Interface, namespace string, options api.ListOptions) (
+		watch.Interface, error)
+}
+
+// Resources that can be watched, by their names in the API paths.
+var watchedResources = map[string]watchedResource{
+	"pods": {"Pod", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Pods(namespace).Watch(options)
+	}},
+	"replicationcontrollers": {"ReplicationController", func(client client.Interface,
+		namespace string, options api.ListOptions) (watch.Interface, error) {
+		return client.ReplicationControllers(namespace).Watch(options)
+	}},
+	"replicasets": {"ReplicaSet", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Extensions().ReplicaSets(namespace).Watch(options)
+	}},
+	"deployments": {"Deployment", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Extensions().Deployments(namespace).Watch(options)
+	}},
+	"jobs": {"Job", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Batch().Jobs(namespace).Watch(options)
+	}},
+	"daemonsets": {"DaemonSet", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Extensions().DaemonSets(namespace).Watch(options)
+	}},
+	"ingresses": {"Ingress", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Extensions().Ingress(namespace).Watch(options)
+	}},
+	"services": {"Service", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Services(namespace).Watch(options)
+	}},
+	"secrets": {"Secret", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Secrets(namespace).Watch(options)
+	}},
+	"events": {"Event", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Events(namespace).Watch(options)
+	}},
+	"nodes": {"Node", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Nodes().Watch(options)
+	}},
+	"namespaces": {"Namespace", func(client client.Interface, namespace string,
+		options api.ListOptions) (watch.Interface, error) {
+		return client.Namespaces().Watch(options)
+	}},
+}
+
+// CanWatch returns true when the resource with the given name, e.g., "pods", can be watched.
+func CanWatch(resource string) bool {
+	_, ok := watchedResources[resource]
+	return ok
+}
+
+// Watcher delivers notifications about changes of watched resources.
+type Watcher struct {
+	watcher       watch.Interface
+	kind          string
+	notifications chan Notification
+	err           error
+	stopped       chan struct{}
+	stopOnce      sync.Once
+}
+
+// Watch starts watching resources described by the spec. The watch ends when it is stopped or when
+// the Apiserver closes it, which it does periodically. Watching can then be resumed from the
+// resource version of the last notification.
+func Watch(client client.Interface, spec WatchSpec) (*Watcher, error) {
+	resource, ok := watchedResources[spec.Resource]
+	if !ok {
+		return nil, fmt.Errorf("Resource %s cannot be watched", spec.Resource)
+	}
+
+	log.Printf("Watching %s in %s", spec.Resource, common.DescribeNamespace(spec.Namespace))
+	watcher, err := resource.watch(client, spec.Namespace, api.ListOptions{
+		LabelSelector:   spec.LabelSelector,
+		ResourceVersion: spec.ResourceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Watcher{
+		watcher:       watcher,
+		kind:          resource.kind,
+		notifications: make(chan Notification),
+		stopped:       make(chan struct{}),
+	}
+	go result.receive()
+	return result, nil
+}
+
+// Notifications returns a channel of notifications. It is closed when the watch ends.
+func (w *Watcher) Notifications() <-chan Notification {
+	return w.notifications
+}
+
+// Err returns the error that ended the watch, if any. It should be called after the channel of
+// notifications is closed.
+func (w *Watcher) Err() error {
+	return w.err
+}
+
+// Stop stops watching. Notifications that are not received yet are dropped.
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopped)
+		w.watcher.Stop()
+	})
+}
+
+// Converts Apiserver watch events to notifications until the watch ends.
+func (w *Watcher) receive() {
+	defer close(w.notifications)
+	for event := range w.watcher.ResultChan() {
+		notification, err := toNotification(event, w.kind)
+		if err != nil {
+			w.err = err
+			w.Stop()
+			return
+		}
+
+		select {
+		case w.notifications <- *notification:
+		case <-w.stopped:
+			return
+		}
+	}
+}
+
+// Returns notification about the change described by the watch event.
+func toNotification(event watch.Event, kind string) (*Notification, error) {
+	if event.Type == watch.Error {
+		return nil, k8serrors.FromObject(event.Object)
+	}
+
+	objectMeta, err := api.ObjectMetaFor(event.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		Type:            event.Type,
+		ObjectMeta:      common.CreateObjectMeta(*objectMeta),
+		TypeMeta:        common.CreateTypeMeta(unversioned.TypeMeta{Kind: kind}),
+		ResourceVersion: objectMeta.ResourceVersion,
+	}, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolume
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// StorageSourceType is a kind of storage that backs a Persistent Volume.
+type StorageSourceType string
+
+// Kinds of storage that can back a Persistent Volume.
+const (
+	GCEPersistentDiskSource    StorageSourceType = "GCEPersistentDisk"
+	AWSElasticBlockStoreSource StorageSourceType = "AWSElasticBlockStore"
+	HostPathSource             StorageSourceType = "HostPath"
+	GlusterfsSource            StorageSourceType = "Glusterfs"
+	NFSSource                  StorageSourceType = "NFS"
+	RBDSource                  StorageSourceType = "RBD"
+	ISCSISource                StorageSourceType = "ISCSI"
+	FlexVolumeSource           StorageSourceType = "FlexVolume"
+	CinderSource               StorageSourceType = "Cinder"
+	CephFSSource               StorageSourceType = "CephFS"
+	FCSource                   StorageSourceType = "FC"
+	FlockerSource              StorageSourceType = "Flocker"
+	AzureFileSource            StorageSourceType = "AzureFile"
+
+	// UnknownSource is used when no known storage source is set on the volume.
+	UnknownSource StorageSourceType = "Unknown"
+)
+
+// GetStorageSourceType returns the kind of storage set in the given source. Exactly one of them is
+// set on a valid volume.
+func GetStorageSourceType(source api.PersistentVolumeSource) StorageSourceType {
+	switch {
+	case source.GCEPersistentDisk != nil:
+		return GCEPersistentDiskSource
+	case source.AWSElasticBlockStore != nil:
+		return AWSElasticBlockStoreSource
+	case source.HostPath != nil:
+		return HostPathSource
+	case source.Glusterfs != nil:
+		return GlusterfsSource
+	case source.NFS != nil:
+		return NFSSource
+	case source.RBD != nil:
+		return RBDSource
+	case source.ISCSI != nil:
+		return ISCSISource
+	case source.FlexVolume != nil:
+		return FlexVolumeSource
+	case source.Cinder != nil:
+		return CinderSource
+	case source.CephFS != nil:
+		return CephFSSource
+	case source.FC != nil:
+		return FCSource
+	case source.Flocker != nil:
+		return FlockerSource
+	case source.AzureFile != nil:
+		return AzureFileSource
+	}
+	return UnknownSource
+}
+
+// Returns the claim that the volume is bound to or nil when it is not bound.
+func getClaimReference(persistentVolume *api.PersistentVolume) *ClaimReference {
+	if persistentVolume.Spec.ClaimRef == nil {
+		return nil
+	}
+	return &ClaimReference{
+		Namespace: persistentVolume.Spec.ClaimRef.Namespace,
+		Name:      persistentVolume.Spec.ClaimRef.Name,
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolume
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// PersistentVolumeDetail represents detailed information about a Persistent Volume.
+type PersistentVolumeDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Storage capacity of the volume.
+	Capacity api.ResourceList `json:"capacity"`
+
+	// Ways the volume can be mounted.
+	AccessModes []api.PersistentVolumeAccessMode `json:"accessModes"`
+
+	// What happens to the volume when it is released from its claim.
+	ReclaimPolicy api.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"`
+
+	// Phase of the volume, e.g., Available or Bound.
+	Status api.PersistentVolumePhase `json:"status"`
+
+	// Human readable message about the phase of the volume, e.g., why it failed.
+	Message string `json:"message"`
+
+	// Machine readable reason of the phase of the volume.
+	Reason string `json:"reason"`
+
+	// Claim that the volume is bound to. Nil when it is not bound.
+	Claim *ClaimReference `json:"claim"`
+
+	// Kind of storage that backs the volume, e.g., NFS or GCEPersistentDisk.
+	StorageSource StorageSourceType `json:"storageSource"`
+
+	// Configuration of the storage that backs the volume.
+	PersistentVolumeSource api.PersistentVolumeSource `json:"persistentVolumeSource"`
+}
+
+// GetPersistentVolumeDetail returns detailed information about the given persistent volume.
+func GetPersistentVolumeDetail(client client.Interface, name string) (*PersistentVolumeDetail,
+	error) {
+	log.Printf("Getting details of %s persistent volume", name)
+
+	persistentVolume, err := client.PersistentVolumes().Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return getPersistentVolumeDetail(persistentVolume), nil
+}
+
+func getPersistentVolumeDetail(persistentVolume *api.PersistentVolume) *PersistentVolumeDetail {
+	return &PersistentVolumeDetail{
+		ObjectMeta:             common.CreateObjectMeta(persistentVolume.ObjectMeta),
+		TypeMeta:               common.CreateTypeMeta(persistentVolume.TypeMeta),
+		Capacity:               persistentVolume.Spec.Capacity,
+		AccessModes:            persistentVolume.Spec.AccessModes,
+		ReclaimPolicy:          persistentVolume.Spec.PersistentVolumeReclaimPolicy,
+		Status:                 persistentVolume.Status.Phase,
+		Message:                persistentVolume.Status.Message,
+		Reason:                 persistentVolume.Status.Reason,
+		Claim:                  getClaimReference(persistentVolume),
+		StorageSource:          GetStorageSourceType(persistentVolume.Spec.PersistentVolumeSource),
+		PersistentVolumeSource: persistentVolume.Spec.PersistentVolumeSource,
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolume
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// PersistentVolumeList contains a list of Persistent Volumes in the cluster.
+type PersistentVolumeList struct {
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Persistent Volumes.
+	PersistentVolumes []PersistentVolume `json:"persistentVolumes"`
+}
+
+// PersistentVolume is a presentation layer view of Kubernetes Persistent Volume resource.
+type PersistentVolume struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Storage capacity of the volume.
+	Capacity api.ResourceList `json:"capacity"`
+
+	// Ways the volume can be mounted.
+	AccessModes []api.PersistentVolumeAccessMode `json:"accessModes"`
+
+	// What happens to the volume when it is released from its claim.
+	ReclaimPolicy api.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"`
+
+	// Phase of the volume, e.g., Available or Bound.
+	Status api.PersistentVolumePhase `json:"status"`
+
+	// Claim that the volume is bound to. Nil when it is not bound.
+	Claim *ClaimReference `json:"claim"`
+
+	// Kind of storage that backs the volume, e.g., NFS or GCEPersistentDisk.
+	StorageSource StorageSourceType `json:"storageSource"`
+}
+
+// ClaimReference identifies a Persistent Volume Claim.
+type ClaimReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// GetPersistentVolumeList returns a list of all Persistent Volumes in the cluster.
+func GetPersistentVolumeList(client client.Interface,
+	dsQuery *common.DataSelectQuery) (*PersistentVolumeList, error) {
+	log.Printf("Getting list of persistent volumes")
+
+	channels := &common.ResourceChannels{
+		PersistentVolumeList: common.GetPersistentVolumeListChannel(client, 1),
+	}
+
+	return GetPersistentVolumeListFromChannels(channels, dsQuery)
+}
+
+// GetPersistentVolumeListFromChannels returns a list of all Persistent Volumes in the cluster
+// reading required resource list once from the channels.
+func GetPersistentVolumeListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*PersistentVolumeList, error) {
+
+	persistentVolumes := <-channels.PersistentVolumeList.List
+	if err := <-channels.PersistentVolumeList.Error; err != nil {
+		return nil, err
+	}
+
+	selected, listMeta := selectPersistentVolumes(persistentVolumes.Items, dsQuery)
+	result := &PersistentVolumeList{
+		ListMeta:          listMeta,
+		PersistentVolumes: make([]PersistentVolume, 0),
+	}
+	for _, persistentVolume := range selected {
+		result.PersistentVolumes = append(result.PersistentVolumes,
+			ToPersistentVolume(&persistentVolume))
+	}
+
+	return result, nil
+}
+
+// ToPersistentVolume returns presentation layer view of the given Persistent Volume.
+func ToPersistentVolume(persistentVolume *api.PersistentVolume) PersistentVolume {
+	return PersistentVolume{
+		ObjectMeta:    common.CreateObjectMeta(persistentVolume.ObjectMeta),
+		TypeMeta:      common.CreateTypeMeta(persistentVolume.TypeMeta),
+		Capacity:      persistentVolume.Spec.Capacity,
+		AccessModes:   persistentVolume.Spec.AccessModes,
+		ReclaimPolicy: persistentVolume.Spec.PersistentVolumeReclaimPolicy,
+		Status:        persistentVolume.Status.Phase,
+		Claim:         getClaimReference(persistentVolume),
+		StorageSource: GetStorageSourceType(persistentVolume.Spec.PersistentVolumeSource),
+	}
+}
+
+// Persistent Volume list item that data select queries are applied to.
+type persistentVolumeCell api.PersistentVolume
+
+func (p persistentVolumeCell) GetObjectMeta() api.ObjectMeta {
+	return p.ObjectMeta
+}
+
+func (p persistentVolumeCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	if name == common.StatusProperty {
+		return common.StdComparableString(p.Status.Phase)
+	}
+	return nil
+}
+
+// Returns Persistent Volumes selected by the query together with metadata of the whole filtered
+// list.
+func selectPersistentVolumes(persistentVolumes []api.PersistentVolume,
+	dsQuery *common.DataSelectQuery) ([]api.PersistentVolume, common.ListMeta) {
+
+	cells := make([]common.DataCell, len(persistentVolumes))
+	for i := range persistentVolumes {
+		cells[i] = persistentVolumeCell(persistentVolumes[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]api.PersistentVolume, len(cells))
+	for i := range cells {
+		result[i] = api.PersistentVolume(cells[i].(persistentVolumeCell))
+	}
+	return result, listMeta
+}
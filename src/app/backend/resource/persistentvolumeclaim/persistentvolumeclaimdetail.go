@@ -0,0 +1,110 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/pod"
+	"k8s.io/kubernetes/pkg/api"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// PersistentVolumeClaimDetail represents detailed information about a Persistent Volume Claim.
+type PersistentVolumeClaimDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Phase of the claim, e.g., Pending or Bound.
+	Status api.PersistentVolumeClaimPhase `json:"status"`
+
+	// Name of the volume that the claim is bound to. Empty when it is not bound.
+	Volume string `json:"volume"`
+
+	// Storage requested by the claim.
+	Request api.ResourceList `json:"request"`
+
+	// Ways of mounting the volume requested by the claim.
+	RequestedAccessModes []api.PersistentVolumeAccessMode `json:"requestedAccessModes"`
+
+	// Storage capacity of the bound volume.
+	Capacity api.ResourceList `json:"capacity"`
+
+	// Ways the bound volume can be mounted.
+	AccessModes []api.PersistentVolumeAccessMode `json:"accessModes"`
+
+	// Pods in the namespace of the claim that mount it.
+	Pods pod.PodList `json:"pods"`
+}
+
+// GetPersistentVolumeClaimDetail returns detailed information about the given persistent volume
+// claim in the given namespace, together with the pods that mount it.
+func GetPersistentVolumeClaimDetail(client k8sClient.Interface,
+	heapsterClient client.HeapsterClient, namespace, name string) (
+	*PersistentVolumeClaimDetail, error) {
+	log.Printf("Getting details of %s persistent volume claim in %s namespace", name, namespace)
+
+	channels := &common.ResourceChannels{
+		PodList: common.GetPodListChannel(client, namespace, 1),
+	}
+
+	claim, err := client.PersistentVolumeClaims(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := <-channels.PodList.List
+	if err := <-channels.PodList.Error; err != nil {
+		return nil, err
+	}
+
+	detail := getPersistentVolumeClaimDetail(claim)
+	detail.Pods = pod.CreatePodList(getClaimPods(claim.Name, pods.Items), common.NoDataSelect,
+		heapsterClient)
+
+	return detail, nil
+}
+
+func getPersistentVolumeClaimDetail(
+	claim *api.PersistentVolumeClaim) *PersistentVolumeClaimDetail {
+
+	return &PersistentVolumeClaimDetail{
+		ObjectMeta:           common.CreateObjectMeta(claim.ObjectMeta),
+		TypeMeta:             common.CreateTypeMeta(claim.TypeMeta),
+		Status:               claim.Status.Phase,
+		Volume:               claim.Spec.VolumeName,
+		Request:              claim.Spec.Resources.Requests,
+		RequestedAccessModes: claim.Spec.AccessModes,
+		Capacity:             claim.Status.Capacity,
+		AccessModes:          claim.Status.AccessModes,
+	}
+}
+
+// Returns pods that mount the claim with the given name in any of their volumes.
+func getClaimPods(claimName string, pods []api.Pod) []api.Pod {
+	result := make([]api.Pod, 0)
+	for _, pod := range pods {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil &&
+				volume.PersistentVolumeClaim.ClaimName == claimName {
+				result = append(result, pod)
+				break
+			}
+		}
+	}
+	return result
+}
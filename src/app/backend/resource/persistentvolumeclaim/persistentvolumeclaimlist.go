@@ -0,0 +1,132 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+// PersistentVolumeClaimList contains a list of Persistent Volume Claims in the cluster.
+type PersistentVolumeClaimList struct {
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Persistent Volume Claims.
+	PersistentVolumeClaims []PersistentVolumeClaim `json:"persistentVolumeClaims"`
+}
+
+// PersistentVolumeClaim is a presentation layer view of Kubernetes Persistent Volume Claim
+// resource.
+type PersistentVolumeClaim struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Phase of the claim, e.g., Pending or Bound.
+	Status api.PersistentVolumeClaimPhase `json:"status"`
+
+	// Name of the volume that the claim is bound to. Empty when it is not bound.
+	Volume string `json:"volume"`
+
+	// Storage capacity of the bound volume.
+	Capacity api.ResourceList `json:"capacity"`
+
+	// Ways the bound volume can be mounted.
+	AccessModes []api.PersistentVolumeAccessMode `json:"accessModes"`
+}
+
+// GetPersistentVolumeClaimList returns a list of Persistent Volume Claims in the given namespace,
+// or in all namespaces when it is api.NamespaceAll.
+func GetPersistentVolumeClaimList(client client.Interface, namespace string,
+	dsQuery *common.DataSelectQuery) (*PersistentVolumeClaimList, error) {
+	log.Printf("Getting list of persistent volume claims in %s",
+		common.DescribeNamespace(namespace))
+
+	channels := &common.ResourceChannels{
+		PersistentVolumeClaimList: common.GetPersistentVolumeClaimListChannel(client, namespace,
+			1),
+	}
+
+	return GetPersistentVolumeClaimListFromChannels(channels, dsQuery)
+}
+
+// GetPersistentVolumeClaimListFromChannels returns a list of all Persistent Volume Claims in the
+// cluster reading required resource list once from the channels.
+func GetPersistentVolumeClaimListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*PersistentVolumeClaimList, error) {
+
+	claims := <-channels.PersistentVolumeClaimList.List
+	if err := <-channels.PersistentVolumeClaimList.Error; err != nil {
+		return nil, err
+	}
+
+	selected, listMeta := selectPersistentVolumeClaims(claims.Items, dsQuery)
+	result := &PersistentVolumeClaimList{
+		ListMeta:               listMeta,
+		PersistentVolumeClaims: make([]PersistentVolumeClaim, 0),
+	}
+	for _, claim := range selected {
+		result.PersistentVolumeClaims = append(result.PersistentVolumeClaims,
+			ToPersistentVolumeClaim(&claim))
+	}
+
+	return result, nil
+}
+
+// ToPersistentVolumeClaim returns presentation layer view of the given Persistent Volume Claim.
+func ToPersistentVolumeClaim(claim *api.PersistentVolumeClaim) PersistentVolumeClaim {
+	return PersistentVolumeClaim{
+		ObjectMeta:  common.CreateObjectMeta(claim.ObjectMeta),
+		TypeMeta:    common.CreateTypeMeta(claim.TypeMeta),
+		Status:      claim.Status.Phase,
+		Volume:      claim.Spec.VolumeName,
+		Capacity:    claim.Status.Capacity,
+		AccessModes: claim.Status.AccessModes,
+	}
+}
+
+// Persistent Volume Claim list item that data select queries are applied to.
+type persistentVolumeClaimCell api.PersistentVolumeClaim
+
+func (p persistentVolumeClaimCell) GetObjectMeta() api.ObjectMeta {
+	return p.ObjectMeta
+}
+
+func (p persistentVolumeClaimCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	if name == common.StatusProperty {
+		return common.StdComparableString(p.Status.Phase)
+	}
+	return nil
+}
+
+// Returns Persistent Volume Claims selected by the query together with metadata of the whole
+// filtered list.
+func selectPersistentVolumeClaims(claims []api.PersistentVolumeClaim,
+	dsQuery *common.DataSelectQuery) ([]api.PersistentVolumeClaim, common.ListMeta) {
+
+	cells := make([]common.DataCell, len(claims))
+	for i := range claims {
+		cells[i] = persistentVolumeClaimCell(claims[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]api.PersistentVolumeClaim, len(cells))
+	for i := range cells {
+		result[i] = api.PersistentVolumeClaim(cells[i].(persistentVolumeClaimCell))
+	}
+	return result, listMeta
+}
@@ -0,0 +1,226 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller"
+	qosutil "k8s.io/kubernetes/pkg/kubelet/qos/util"
+)
+
+// PodDetail is a presentation layer view of Kubernetes Pod resource. This means it is Pod plus
+// additional augumented data we can get from other sources (like events that involve it).
+type PodDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Status of the Pod. See Kubernetes API for reference.
+	PodPhase api.PodPhase `json:"podPhase"`
+
+	// IP address of the Pod.
+	PodIP string `json:"podIP"`
+
+	// Name of the Node this Pod runs on.
+	NodeName string `json:"nodeName"`
+
+	// Count of containers restarts.
+	RestartCount int `json:"restartCount"`
+
+	// Quality of service class of the Pod: Guaranteed, Burstable or BestEffort.
+	QOSClass string `json:"qosClass"`
+
+	// Reference to the controller that created the Pod. Nil when the Pod was created directly.
+	Controller *api.ObjectReference `json:"controller"`
+
+	// Detailed information about containers of the Pod.
+	Containers []Container `json:"containers"`
+
+	// Current service state of the Pod.
+	Conditions []api.PodCondition `json:"conditions"`
+
+	// Volumes that can be mounted by containers of the Pod.
+	Volumes []api.Volume `json:"volumes"`
+
+	// Events related to the Pod.
+	Events event.Events `json:"events"`
+
+	// Pod metrics.
+	Metrics *PodMetrics `json:"metrics"`
+}
+
+// Container is a representation of a Pod container together with its current status.
+type Container struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// Image of the container.
+	Image string `json:"image"`
+
+	// ID of the image the container runs. Empty when the container has not started yet.
+	ImageID string `json:"imageID"`
+
+	// Entrypoint array of the container.
+	Command []string `json:"command"`
+
+	// Arguments to the entrypoint of the container.
+	Args []string `json:"args"`
+
+	// List of environment variables to set in the container.
+	Env []api.EnvVar `json:"env"`
+
+	// Pod volumes mounted into the container's filesystem.
+	VolumeMounts []api.VolumeMount `json:"volumeMounts"`
+
+	// Periodic probe of container liveness.
+	LivenessProbe *api.Probe `json:"livenessProbe"`
+
+	// Periodic probe of container service readiness.
+	ReadinessProbe *api.Probe `json:"readinessProbe"`
+
+	// Compute resources required by the container.
+	Resources api.ResourceRequirements `json:"resources"`
+
+	// Current state of the container: waiting, running or terminated, with reasons and exit
+	// codes where applicable.
+	State api.ContainerState `json:"state"`
+
+	// Last termination state of the container, useful for debugging containers that crash.
+	LastTerminationState api.ContainerState `json:"lastTerminationState"`
+
+	// Whether the container has passed its readiness probe.
+	Ready bool `json:"ready"`
+
+	// Number of times the container has been restarted.
+	RestartCount int `json:"restartCount"`
+}
+
+// GetPodDetail returns detailed information about the given pod in the given namespace.
+func GetPodDetail(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace, name string) (*PodDetail, error) {
+	log.Printf("Getting details of %s pod in %s namespace", name, namespace)
+
+	pod, err := client.Pods(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := event.GetObjectEvents(client, namespace, "Pod", name)
+	if err != nil {
+		return nil, err
+	}
+
+	podDetail := toPodDetail(pod, events)
+
+	metrics, err := getPodMetrics([]api.Pod{*pod}, heapsterClient)
+	if err != nil {
+		log.Printf("Skipping Heapster metrics because of error: %s\n", err)
+	} else if podMetrics, ok := metrics.MetricsMap[pod.Namespace][pod.Name]; ok {
+		podDetail.Metrics = &podMetrics
+	}
+
+	return podDetail, nil
+}
+
+func toPodDetail(pod *api.Pod, events []api.Event) *PodDetail {
+	return &PodDetail{
+		ObjectMeta:   common.CreateObjectMeta(pod.ObjectMeta),
+		TypeMeta:     common.CreateTypeMeta(pod.TypeMeta),
+		PodPhase:     pod.Status.Phase,
+		PodIP:        pod.Status.PodIP,
+		NodeName:     pod.Spec.NodeName,
+		RestartCount: getRestartCount(*pod),
+		QOSClass:     getPodQOSClass(pod),
+		Controller:   getPodController(pod),
+		Containers:   getPodContainers(pod),
+		Conditions:   pod.Status.Conditions,
+		Volumes:      pod.Spec.Volumes,
+		Events:       event.CreateEvents(events, pod.Namespace),
+	}
+}
+
+// Returns containers of the given pod merged with their statuses.
+func getPodContainers(pod *api.Pod) []Container {
+	statuses := make(map[string]api.ContainerStatus)
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status
+	}
+
+	containers := make([]Container, 0)
+	for _, container := range pod.Spec.Containers {
+		status := statuses[container.Name]
+		containers = append(containers, Container{
+			Name:                 container.Name,
+			Image:                container.Image,
+			ImageID:              status.ImageID,
+			Command:              container.Command,
+			Args:                 container.Args,
+			Env:                  container.Env,
+			VolumeMounts:         container.VolumeMounts,
+			LivenessProbe:        container.LivenessProbe,
+			ReadinessProbe:       container.ReadinessProbe,
+			Resources:            container.Resources,
+			State:                status.State,
+			LastTerminationState: status.LastTerminationState,
+			Ready:                status.Ready,
+			RestartCount:         status.RestartCount,
+		})
+	}
+	return containers
+}
+
+// Returns quality of service class of the pod. Pod is Guaranteed when all its containers are
+// guaranteed all resources, BestEffort when none of them request any resources and Burstable
+// otherwise.
+func getPodQOSClass(pod *api.Pod) string {
+	qosClasses := make(map[string]bool)
+	for _, container := range pod.Spec.Containers {
+		for _, qosClass := range qosutil.GetQoS(&container) {
+			qosClasses[qosClass] = true
+		}
+	}
+
+	if len(qosClasses) == 0 {
+		return qosutil.BestEffort
+	}
+	if len(qosClasses) == 1 {
+		for qosClass := range qosClasses {
+			return qosClass
+		}
+	}
+	return qosutil.Burstable
+}
+
+// Returns reference to the controller that created the pod, based on the created-by annotation.
+// Returns nil when the pod was not created by a controller.
+func getPodController(pod *api.Pod) *api.ObjectReference {
+	createdBy, ok := pod.Annotations[controller.CreatedByAnnotation]
+	if !ok {
+		return nil
+	}
+
+	reference := &api.SerializedReference{}
+	if err := json.Unmarshal([]byte(createdBy), reference); err != nil {
+		log.Printf("Cannot read controller reference of %s pod: %s", pod.Name, err)
+		return nil
+	}
+	return &reference.Reference
+}
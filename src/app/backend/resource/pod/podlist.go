@@ -25,7 +25,9 @@ import (
 
 // ReplicationSetList contains a list of Pods in the cluster.
 type PodList struct {
-	// Unordered list of Pods.
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Pods.
 	Pods []Pod `json:"pods"`
 }
 
@@ -55,39 +57,47 @@ type Pod struct {
 	Metrics *PodMetrics `json:"metrics"`
 }
 
-// GetPodList returns a list of all Pods in the cluster.
-func GetPodList(client k8sClient.Interface, heapsterClient client.HeapsterClient) (*PodList, error) {
-	log.Printf("Getting list of all pods in the cluster")
+// GetPodList returns a list of Pods in the given namespace, or in all namespaces when it is
+// api.NamespaceAll.
+func GetPodList(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace string, dsQuery *common.DataSelectQuery) (*PodList, error) {
+	log.Printf("Getting list of pods in %s", common.DescribeNamespace(namespace))
 
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannel(client, 1),
+		PodList: common.GetPodListChannel(client, namespace, 1),
 	}
 
-	return GetPodListFromChannels(channels, heapsterClient)
+	return GetPodListFromChannels(channels, dsQuery, heapsterClient)
 }
 
 // GetPodList returns a list of all Pods in the cluster
 // reading required resource list once from the channels.
-func GetPodListFromChannels(channels *common.ResourceChannels, heapsterClient client.HeapsterClient) (
-	*PodList, error) {
+func GetPodListFromChannels(channels *common.ResourceChannels, dsQuery *common.DataSelectQuery,
+	heapsterClient client.HeapsterClient) (*PodList, error) {
 
 	pods := <-channels.PodList.List
 	if err := <-channels.PodList.Error; err != nil {
 		return nil, err
 	}
 
-	podList := CreatePodList(pods.Items, heapsterClient)
+	podList := CreatePodList(pods.Items, dsQuery, heapsterClient)
 	return &podList, nil
 }
 
-func CreatePodList(pods []api.Pod, heapsterClient client.HeapsterClient) PodList {
+// CreatePodList returns a list of presentation layer views of the Pods selected by the query.
+// Metrics are fetched only for the selected Pods.
+func CreatePodList(pods []api.Pod, dsQuery *common.DataSelectQuery,
+	heapsterClient client.HeapsterClient) PodList {
+
+	pods, listMeta := selectPods(pods, dsQuery)
 	metrics, err := getPodMetrics(pods, heapsterClient)
 	if err != nil {
 		log.Printf("Skipping Heapster metrics because of error: %s\n", err)
 	}
 
 	podList := PodList{
-		Pods: make([]Pod, 0),
+		ListMeta: listMeta,
+		Pods:     make([]Pod, 0),
 	}
 
 	for _, pod := range pods {
@@ -116,3 +126,36 @@ func getRestartCount(pod api.Pod) int {
 	}
 	return restartCount
 }
+
+// Pod list item that data select queries are applied to.
+type podCell api.Pod
+
+func (p podCell) GetObjectMeta() api.ObjectMeta {
+	return p.ObjectMeta
+}
+
+func (p podCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	switch name {
+	case common.StatusProperty:
+		return common.StdComparableString(p.Status.Phase)
+	case common.RestartsProperty:
+		return common.StdComparableInt(getRestartCount(api.Pod(p)))
+	default:
+		return nil
+	}
+}
+
+// Returns Pods selected by the query together with metadata of the whole filtered list.
+func selectPods(pods []api.Pod, dsQuery *common.DataSelectQuery) ([]api.Pod, common.ListMeta) {
+	cells := make([]common.DataCell, len(pods))
+	for i := range pods {
+		cells[i] = podCell(pods[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]api.Pod, len(cells))
+	for i := range cells {
+		result[i] = api.Pod(cells[i].(podCell))
+	}
+	return result, listMeta
+}
@@ -17,9 +17,89 @@ package replicaset
 import (
 	"github.com/kubernetes/dashboard/resource/common"
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 )
 
+// ReplicaSetWithPods is a structure representing replica set and its pods.
+type ReplicaSetWithPods struct {
+	ReplicaSet *extensions.ReplicaSet
+	Pods       *api.PodList
+}
+
+// Returns structure containing ReplicaSet and Pods for the given replica set.
+func getRawReplicaSetWithPods(client client.Interface, namespace, name string) (
+	*ReplicaSetWithPods, error) {
+	replicaSet, err := client.Extensions().ReplicaSets(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	labelSelector, err := unversioned.LabelSelectorAsSelector(replicaSet.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.Pods(namespace).List(
+		api.ListOptions{
+			LabelSelector: labelSelector,
+			FieldSelector: fields.Everything(),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSetAndPods := &ReplicaSetWithPods{
+		ReplicaSet: replicaSet,
+		Pods:       pods,
+	}
+	return replicaSetAndPods, nil
+}
+
+// Retrieves Pod list that belongs to a Replica Set.
+func getRawReplicaSetPods(client client.Interface, namespace, name string) (*api.PodList, error) {
+	replicaSetAndPods, err := getRawReplicaSetWithPods(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return replicaSetAndPods.Pods, nil
+}
+
+// Based on given selector returns list of services that are candidates for deletion.
+// Services are matched by replica sets' label selector. They are deleted if given
+// label selector is targeting only 1 replica set.
+func getServicesForDeletion(client client.Interface, labelSelector labels.Selector,
+	namespace string) ([]api.Service, error) {
+
+	replicaSets, err := client.Extensions().ReplicaSets(namespace).List(api.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// if label selector is targeting only 1 replica set
+	// then we can delete services targeted by this label selector,
+	// otherwise we can not delete any services so just return empty list
+	if len(replicaSets.Items) != 1 {
+		return []api.Service{}, nil
+	}
+
+	services, err := client.Services(namespace).List(api.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return services.Items, nil
+}
+
 // getPodInfo returns aggregate information about replica set pods.
 func getPodInfo(resource *extensions.ReplicaSet,
 	pods []api.Pod) common.PodInfo {
@@ -0,0 +1,229 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicaset
+
+import (
+	"log"
+
+	"github.com/kubernetes/dashboard/client"
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/pod"
+	resourceService "github.com/kubernetes/dashboard/resource/service"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// ReplicaSetDetail represents detailed information about a Replica Set.
+type ReplicaSetDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Label selector of the Replica Set.
+	Selector *unversioned.LabelSelector `json:"selector"`
+
+	// Container image list of the pod template specified by this Replica Set.
+	ContainerImages []string `json:"containerImages"`
+
+	// Aggregate information about pods of this Replica Set.
+	PodInfo common.PodInfo `json:"podInfo"`
+
+	// Detailed information about Pods belonging to this Replica Set.
+	Pods pod.PodList `json:"pods"`
+
+	// Detailed information about services related to this Replica Set.
+	ServiceList resourceService.ServiceList `json:"serviceList"`
+
+	// Events related to the Replica Set.
+	Events event.Events `json:"events"`
+}
+
+// ReplicaSetSpec contains information needed to update replica set.
+type ReplicaSetSpec struct {
+	// Replicas (pods) number in replicas set
+	Replicas int `json:"replicas"`
+}
+
+// GetReplicaSetDetail returns detailed information about the given replica set in the given
+// namespace.
+func GetReplicaSetDetail(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace, name string) (*ReplicaSetDetail, error) {
+	log.Printf("Getting details of %s replica set in %s namespace", name, namespace)
+
+	replicaSetWithPods, err := getRawReplicaSetWithPods(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	replicaSet := replicaSetWithPods.ReplicaSet
+	pods := replicaSetWithPods.Pods
+
+	services, err := client.Services(namespace).List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := client.Nodes().List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := event.GetObjectEvents(client, namespace, "ReplicaSet", name)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSetDetail := getReplicaSetDetail(replicaSet, pods.Items, services.Items, nodes.Items,
+		events)
+	replicaSetDetail.Pods = pod.CreatePodList(pods.Items, common.NoDataSelect, heapsterClient)
+
+	return replicaSetDetail, nil
+}
+
+// DeleteReplicaSet deletes replica set with given name in given namespace and related pods.
+// Also deletes services related to replica set if deleteServices is true.
+func DeleteReplicaSet(client k8sClient.Interface, namespace, name string,
+	deleteServices bool) error {
+
+	log.Printf("Deleting %s replica set from %s namespace", name, namespace)
+
+	if deleteServices {
+		if err := DeleteReplicaSetServices(client, namespace, name); err != nil {
+			return err
+		}
+	}
+
+	pods, err := getRawReplicaSetPods(client, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Extensions().ReplicaSets(namespace).Delete(name, &api.DeleteOptions{}); err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		if err := client.Pods(namespace).Delete(pod.Name, &api.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Successfully deleted %s replica set from %s namespace", name, namespace)
+
+	return nil
+}
+
+// DeleteReplicaSetServices deletes services related to replica set with given name in given
+// namespace.
+func DeleteReplicaSetServices(client k8sClient.Interface, namespace, name string) error {
+	log.Printf("Deleting services related to %s replica set from %s namespace", name, namespace)
+
+	replicaSet, err := client.Extensions().ReplicaSets(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	labelSelector, err := unversioned.LabelSelectorAsSelector(replicaSet.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	services, err := getServicesForDeletion(client, labelSelector, namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if err := client.Services(namespace).Delete(service.Name); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Successfully deleted services related to %s replica set from %s namespace",
+		name, namespace)
+
+	return nil
+}
+
+// UpdateReplicasCount updates number of replicas in Replica Set based on Replica Set Spec
+func UpdateReplicasCount(client k8sClient.Interface, namespace, name string,
+	replicaSetSpec *ReplicaSetSpec) error {
+	log.Printf("Updating replicas count to %d for %s replica set from %s namespace",
+		replicaSetSpec.Replicas, name, namespace)
+
+	replicaSet, err := client.Extensions().ReplicaSets(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	replicaSet.Spec.Replicas = replicaSetSpec.Replicas
+
+	_, err = client.Extensions().ReplicaSets(namespace).Update(replicaSet)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Successfully updated replicas count to %d for %s replica set from %s namespace",
+		replicaSetSpec.Replicas, name, namespace)
+
+	return nil
+}
+
+func getReplicaSetDetail(replicaSet *extensions.ReplicaSet, pods []api.Pod,
+	services []api.Service, nodes []api.Node, events []api.Event) *ReplicaSetDetail {
+
+	replicaSetDetail := &ReplicaSetDetail{
+		ObjectMeta:      common.CreateObjectMeta(replicaSet.ObjectMeta),
+		TypeMeta:        common.CreateTypeMeta(replicaSet.TypeMeta),
+		Selector:        replicaSet.Spec.Selector,
+		ContainerImages: common.GetContainerImages(&replicaSet.Spec.Template.Spec),
+		PodInfo:         getPodInfo(replicaSet, pods),
+		ServiceList:     resourceService.ServiceList{Services: make([]resourceService.Service, 0)},
+		Events:          event.CreateEvents(events, replicaSet.Namespace),
+	}
+
+	for _, service := range getMatchingServices(services, replicaSet) {
+		result := resourceService.ToService(&service)
+		result.ExternalEndpoints = common.GetExternalEndpoints(pods, service, nodes)
+		replicaSetDetail.ServiceList.Services = append(replicaSetDetail.ServiceList.Services,
+			result)
+	}
+
+	return replicaSetDetail
+}
+
+// Returns services from the namespace of the given replica set that target its pods.
+func getMatchingServices(services []api.Service,
+	replicaSet *extensions.ReplicaSet) []api.Service {
+
+	var matchingServices []api.Service
+	for _, service := range services {
+		if service.ObjectMeta.Namespace == replicaSet.ObjectMeta.Namespace &&
+			common.IsLabelSelectorMatching(service.Spec.Selector, replicaSet.Spec.Template.Labels) {
+
+			matchingServices = append(matchingServices, service)
+		}
+	}
+	return matchingServices
+}
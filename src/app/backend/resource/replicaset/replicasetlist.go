@@ -18,7 +18,6 @@ import (
 	"log"
 
 	"github.com/kubernetes/dashboard/resource/common"
-	"github.com/kubernetes/dashboard/resource/replicationcontroller"
 	"k8s.io/kubernetes/pkg/api"
 	k8serrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/apis/extensions"
@@ -27,7 +26,9 @@ import (
 
 // ReplicationSetList contains a list of Replica Sets in the cluster.
 type ReplicaSetList struct {
-	// Unordered list of Replica Sets.
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Replica Sets.
 	ReplicaSets []ReplicaSet `json:"replicaSets"`
 }
 
@@ -45,25 +46,27 @@ type ReplicaSet struct {
 	ContainerImages []string `json:"containerImages"`
 }
 
-// GetReplicaSetList returns a list of all Replica Sets in the cluster.
-func GetReplicaSetList(client client.Interface) (*ReplicaSetList, error) {
-	log.Printf("Getting list of all replica sets in the cluster")
+// GetReplicaSetList returns a list of Replica Sets in the given namespace, or in all namespaces
+// when it is api.NamespaceAll.
+func GetReplicaSetList(client client.Interface, namespace string,
+	dsQuery *common.DataSelectQuery) (*ReplicaSetList, error) {
+	log.Printf("Getting list of replica sets in %s", common.DescribeNamespace(namespace))
 
 	channels := &common.ResourceChannels{
-		ReplicaSetList: common.GetReplicaSetListChannel(client.Extensions(), 1),
-		ServiceList:    common.GetServiceListChannel(client, 1),
-		PodList:        common.GetPodListChannel(client, 1),
-		EventList:      common.GetEventListChannel(client, 1),
+		ReplicaSetList: common.GetReplicaSetListChannel(client.Extensions(), namespace, 1),
+		ServiceList:    common.GetServiceListChannel(client, namespace, 1),
+		PodList:        common.GetPodListChannel(client, namespace, 1),
+		EventList:      common.GetEventListChannel(client, namespace, 1),
 		NodeList:       common.GetNodeListChannel(client, 1),
 	}
 
-	return GetReplicaSetListFromChannels(channels)
+	return GetReplicaSetListFromChannels(channels, dsQuery)
 }
 
 // GetReplicaSetList returns a list of all Replica Sets in the cluster
 // reading required resource list once from the channels.
-func GetReplicaSetListFromChannels(channels *common.ResourceChannels) (
-	*ReplicaSetList, error) {
+func GetReplicaSetListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*ReplicaSetList, error) {
 
 	replicaSets := <-channels.ReplicaSetList.List
 	if err := <-channels.ReplicaSetList.Error; err != nil {
@@ -97,14 +100,16 @@ func GetReplicaSetListFromChannels(channels *common.ResourceChannels) (
 	}
 
 	return getReplicaSetList(replicaSets.Items, services.Items, pods.Items, events.Items,
-		nodes.Items), nil
+		nodes.Items, dsQuery), nil
 }
 
 func getReplicaSetList(replicaSets []extensions.ReplicaSet,
 	services []api.Service, pods []api.Pod, events []api.Event,
-	nodes []api.Node) *ReplicaSetList {
+	nodes []api.Node, dsQuery *common.DataSelectQuery) *ReplicaSetList {
 
+	replicaSets, listMeta := selectReplicaSets(replicaSets, dsQuery)
 	replicaSetList := &ReplicaSetList{
+		ListMeta:    listMeta,
 		ReplicaSets: make([]ReplicaSet, 0),
 	}
 
@@ -115,13 +120,47 @@ func getReplicaSetList(replicaSets []extensions.ReplicaSet,
 		podInfo := getPodInfo(&replicaSet, matchingPods)
 
 		replicaSetList.ReplicaSets = append(replicaSetList.ReplicaSets,
-			ReplicaSet{
-				ObjectMeta:      common.CreateObjectMeta(replicaSet.ObjectMeta),
-				TypeMeta:        common.CreateTypeMeta(replicaSet.TypeMeta),
-				ContainerImages: replicationcontroller.GetContainerImages(&replicaSet.Spec.Template.Spec),
-				Pods:            podInfo,
-			})
+			ToReplicaSet(&replicaSet, &podInfo))
 	}
 
 	return replicaSetList
 }
+
+// ToReplicaSet returns presentation layer view of the given Replica Set with the given
+// aggregate information about its pods.
+func ToReplicaSet(replicaSet *extensions.ReplicaSet, podInfo *common.PodInfo) ReplicaSet {
+	return ReplicaSet{
+		ObjectMeta:      common.CreateObjectMeta(replicaSet.ObjectMeta),
+		TypeMeta:        common.CreateTypeMeta(replicaSet.TypeMeta),
+		ContainerImages: common.GetContainerImages(&replicaSet.Spec.Template.Spec),
+		Pods:            *podInfo,
+	}
+}
+
+// Replica Set list item that data select queries are applied to.
+type replicaSetCell extensions.ReplicaSet
+
+func (r replicaSetCell) GetObjectMeta() api.ObjectMeta {
+	return r.ObjectMeta
+}
+
+func (r replicaSetCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	return nil
+}
+
+// Returns Replica Sets selected by the query together with metadata of the whole filtered list.
+func selectReplicaSets(replicaSets []extensions.ReplicaSet, dsQuery *common.DataSelectQuery) (
+	[]extensions.ReplicaSet, common.ListMeta) {
+
+	cells := make([]common.DataCell, len(replicaSets))
+	for i := range replicaSets {
+		cells[i] = replicaSetCell(replicaSets[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]extensions.ReplicaSet, len(cells))
+	for i := range cells {
+		result[i] = extensions.ReplicaSet(cells[i].(replicaSetCell))
+	}
+	return result, listMeta
+}
@@ -19,18 +19,48 @@ import (
 	"log"
 	"strings"
 
+	"github.com/kubernetes/dashboard/resource/deployment"
 	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	kubectlResource "k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/intstr"
 )
 
 const (
 	// DescriptionAnnotationKey is annotation key for a description.
 	DescriptionAnnotationKey = "description"
+
+	// Group version of the extensions API that serves Deployments and Daemon Sets.
+	extensionsGroupVersion = "extensions/v1beta1"
+)
+
+// AppDeploymentKind is a kind of the resource that runs pods of the deployed app.
+type AppDeploymentKind string
+
+const (
+	// ReplicationControllerKind deploys the app as a Replication Controller.
+	ReplicationControllerKind AppDeploymentKind = "ReplicationController"
+
+	// DeploymentKind deploys the app as a Deployment. This is the default when the server supports
+	// the extensions API group.
+	DeploymentKind AppDeploymentKind = "Deployment"
+
+	// DaemonSetKind deploys the app as a Daemon Set, i.e., one pod on each node. Replicas are
+	// ignored in this case.
+	DaemonSetKind AppDeploymentKind = "DaemonSet"
+
+	// JobKind runs the app to completion as a Job. Replicas is the number of pods that must finish
+	// successfully, which run in parallel.
+	JobKind AppDeploymentKind = "Job"
 )
 
 // AppDeploymentSpec is a specification for an app deployment.
@@ -81,8 +111,44 @@ type AppDeploymentSpec struct {
 
 	// Whether to run the container as privileged user (essentially equivalent to root on the host).
 	RunAsPrivileged bool `json:"runAsPrivileged"`
+
+	// Kind of the resource to create for the application. When empty, Deployment is used if the
+	// server supports it and Replication Controller otherwise.
+	Kind AppDeploymentKind `json:"kind"`
+
+	// Optional rolling update parameters, used only when the app is deployed as a Deployment.
+	RollingUpdateStrategy *deployment.RollingUpdateStrategy `json:"rollingUpdateStrategy"`
 }
 
+// AppDeploymentFromFileMode says what to do with objects from the file that already exist.
+type AppDeploymentFromFileMode string
+
+const (
+	// CreateMode creates objects from the file and fails for the ones that already exist.
+	CreateMode AppDeploymentFromFileMode = "create"
+
+	// ApplyMode creates objects from the file that do not exist yet and replaces the existing ones,
+	// so that a changed file can be deployed again.
+	ApplyMode AppDeploymentFromFileMode = "apply"
+)
+
+// ObjectDeploymentStatus is a result of deployment of a single object from a file.
+type ObjectDeploymentStatus string
+
+const (
+	// ObjectCreated means that the object did not exist and was created.
+	ObjectCreated ObjectDeploymentStatus = "created"
+
+	// ObjectUpdated means that the object existed and was replaced.
+	ObjectUpdated ObjectDeploymentStatus = "updated"
+
+	// ObjectValidated means that the object passed all checks in dry run mode.
+	ObjectValidated ObjectDeploymentStatus = "validated"
+
+	// ObjectFailed means that the object could not be deployed or did not pass checks.
+	ObjectFailed ObjectDeploymentStatus = "failed"
+)
+
 // AppDeploymentFromFileSpec is a specification for deployment from file
 type AppDeploymentFromFileSpec struct {
 	// Name of the file
@@ -90,6 +156,13 @@ type AppDeploymentFromFileSpec struct {
 
 	// File content
 	Content string `json:"content"`
+
+	// What to do with objects that already exist. Defaults to CreateMode.
+	Mode AppDeploymentFromFileMode `json:"mode"`
+
+	// Whether to only validate the file and check objects for name conflicts, without deploying
+	// anything.
+	DryRun bool `json:"dryRun"`
 }
 
 // AppDeploymentFromFileResponse is a specification for deployment from file
@@ -102,6 +175,27 @@ type AppDeploymentFromFileResponse struct {
 
 	// Error after create resource
 	Error string `json:"error"`
+
+	// Results of deployment of each object from the file, in file order.
+	Results []ObjectDeploymentResult `json:"results"`
+}
+
+// ObjectDeploymentResult is a result of deployment of a single object from a file.
+type ObjectDeploymentResult struct {
+	// Kind of the object, e.g., Service.
+	Kind string `json:"kind"`
+
+	// Namespace of the object. Empty for objects that are not namespaced.
+	Namespace string `json:"namespace"`
+
+	// Name of the object.
+	Name string `json:"name"`
+
+	// What happened to the object.
+	Status ObjectDeploymentStatus `json:"status"`
+
+	// Error that made deployment of the object fail. Empty when it succeeded.
+	Error string `json:"error"`
 }
 
 // PortMapping is a specification of port mapping for an application deployment.
@@ -141,9 +235,11 @@ type Protocols struct {
 }
 
 // DeployApp deploys an app based on the given configuration. The app is deployed using the given
-// client. App deployment consists of a replication controller and an optional service. Both of them
-// share common labels.
-func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
+// client. App deployment consists of a replication controller, deployment or daemon set, and an
+// optional service. All of them share common labels. Deployment is transactional: when any of the
+// objects cannot be created, the ones created before it are deleted. Returned report lists what
+// was created and rolled back.
+func DeployApp(spec *AppDeploymentSpec, client client.Interface) (*AppDeploymentReport, error) {
 	log.Printf("Deploying %s application into %s namespace", spec.Name, spec.Namespace)
 
 	annotations := map[string]string{}
@@ -194,20 +290,70 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 		Spec:       podSpec,
 	}
 
-	replicationController := &api.ReplicationController{
-		ObjectMeta: objectMeta,
-		Spec: api.ReplicationControllerSpec{
-			Replicas: spec.Replicas,
-			Selector: labels,
-			Template: podTemplate,
-		},
+	transaction := newAppDeploymentTransaction(client, spec.Namespace)
+
+	kind, err := getAppDeploymentKind(spec, client)
+	if err != nil {
+		return transaction.rollback(err)
 	}
 
-	_, err := client.ReplicationControllers(spec.Namespace).Create(replicationController)
+	switch kind {
+	case ReplicationControllerKind:
+		replicationController := &api.ReplicationController{
+			ObjectMeta: objectMeta,
+			Spec: api.ReplicationControllerSpec{
+				Replicas: spec.Replicas,
+				Selector: labels,
+				Template: podTemplate,
+			},
+		}
+		err = transaction.createReplicationController(replicationController)
+	case DeploymentKind:
+		deployment := &extensions.Deployment{
+			ObjectMeta: objectMeta,
+			Spec: extensions.DeploymentSpec{
+				Replicas: spec.Replicas,
+				Selector: &unversioned.LabelSelector{MatchLabels: labels},
+				Template: *podTemplate,
+			},
+		}
+		if spec.RollingUpdateStrategy != nil {
+			deployment.Spec.Strategy = extensions.DeploymentStrategy{
+				Type: extensions.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &extensions.RollingUpdateDeployment{
+					MaxSurge:       spec.RollingUpdateStrategy.MaxSurge,
+					MaxUnavailable: spec.RollingUpdateStrategy.MaxUnavailable,
+				},
+			}
+		}
+		err = transaction.createDeployment(deployment)
+	case DaemonSetKind:
+		daemonSet := &extensions.DaemonSet{
+			ObjectMeta: objectMeta,
+			Spec: extensions.DaemonSetSpec{
+				Selector: &unversioned.LabelSelector{MatchLabels: labels},
+				Template: *podTemplate,
+			},
+		}
+		err = transaction.createDaemonSet(daemonSet)
+	case JobKind:
+		// Pods of jobs must not be restarted after they finish successfully.
+		podTemplate.Spec.RestartPolicy = api.RestartPolicyOnFailure
+		job := &extensions.Job{
+			ObjectMeta: objectMeta,
+			Spec: extensions.JobSpec{
+				Completions: &spec.Replicas,
+				Parallelism: &spec.Replicas,
+				Template:    *podTemplate,
+			},
+		}
+		err = transaction.createJob(job)
+	default:
+		err = fmt.Errorf("Unsupported kind of app deployment: %s", kind)
+	}
 
 	if err != nil {
-		// TODO(bryk): Roll back created resources in case of error.
-		return err
+		return transaction.rollback(err)
 	}
 
 	if len(spec.PortMappings) > 0 {
@@ -239,13 +385,45 @@ func DeployApp(spec *AppDeploymentSpec, client client.Interface) error {
 			service.Spec.Ports = append(service.Spec.Ports, servicePort)
 		}
 
-		_, err = client.Services(spec.Namespace).Create(service)
+		if err := transaction.createService(service); err != nil {
+			return transaction.rollback(err)
+		}
+	}
 
-		// TODO(bryk): Roll back created resources in case of error.
-		return err
-	} else {
-		return nil
+	return transaction.report, nil
+}
+
+// Returns kind of the resource to create for the given app deployment spec. Defaults to
+// Deployment when the server serves them and to Replication Controller otherwise.
+func getAppDeploymentKind(spec *AppDeploymentSpec, client client.Interface) (
+	AppDeploymentKind, error) {
+
+	if spec.Kind != "" {
+		switch spec.Kind {
+		case ReplicationControllerKind, DeploymentKind, DaemonSetKind, JobKind:
+			return spec.Kind, nil
+		}
+		return "", k8serrors.NewBadRequest(fmt.Sprintf("Unsupported kind of app deployment: %s",
+			spec.Kind))
+	}
+
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(extensionsGroupVersion)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// NotFound - this means that the server does not support the extensions API group.
+			return ReplicationControllerKind, nil
+		}
+		return "", err
 	}
+
+	if resources != nil {
+		for _, resource := range resources.APIResources {
+			if resource.Name == "deployments" {
+				return DeploymentKind, nil
+			}
+		}
+	}
+	return ReplicationControllerKind, nil
 }
 
 // GetAvailableProtocols returns list of available protocols. Currently it is TCP and UDP.
@@ -279,48 +457,158 @@ func getLabelsMap(labels []Label) map[string]string {
 	return result
 }
 
-type createObjectFromInfo func(info *kubectlResource.Info) (bool, error)
+type deployObjectFromInfo func(info *kubectlResource.Info, mode AppDeploymentFromFileMode,
+	dryRun bool) (ObjectDeploymentStatus, error)
+
+// DeployObjectFromInfoFn is a implementation of deployObjectFromInfo. It creates the object, or
+// replaces it in ApplyMode when it already exists. In dry run mode it only checks whether the
+// object could be deployed.
+func DeployObjectFromInfoFn(info *kubectlResource.Info, mode AppDeploymentFromFileMode,
+	dryRun bool) (ObjectDeploymentStatus, error) {
+
+	helper := kubectlResource.NewHelper(info.Client, info.Mapping)
+
+	liveObject, err := helper.Get(info.Namespace, info.Name, false)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return ObjectFailed, err
+		}
+		liveObject = nil
+	}
+
+	if liveObject != nil && mode != ApplyMode {
+		return ObjectFailed, k8serrors.NewAlreadyExists(unversioned.GroupResource{
+			Group:    info.Mapping.GroupVersionKind.Group,
+			Resource: info.Mapping.Resource,
+		}, info.Name)
+	}
+
+	if dryRun {
+		return ObjectValidated, nil
+	}
+
+	if liveObject != nil {
+		if err := copyServerFields(liveObject, info.Object); err != nil {
+			return ObjectFailed, err
+		}
+		if _, err := helper.Replace(info.Namespace, info.Name, true, info.Object); err != nil {
+			return ObjectFailed, err
+		}
+		return ObjectUpdated, nil
+	}
+
+	if _, err := helper.Create(info.Namespace, true, info.Object); err != nil {
+		return ObjectFailed, err
+	}
+	return ObjectCreated, nil
+}
+
+// Copies fields that the server sets on the live object to the object that replaces it: resource
+// version, so that changes made since the object was fetched are not overwritten, and cluster IP of
+// a Service, which cannot be changed.
+func copyServerFields(liveObject, object runtime.Object) error {
+	liveAccessor, err := meta.Accessor(liveObject)
+	if err != nil {
+		return err
+	}
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return err
+	}
+	accessor.SetResourceVersion(liveAccessor.GetResourceVersion())
 
-// CreateObjectFromInfoFn is a implementation of createObjectFromInfo
-func CreateObjectFromInfoFn(info *kubectlResource.Info) (bool, error) {
-	createdResource, err := kubectlResource.NewHelper(info.Client, info.Mapping).Create(info.Namespace, true, info.Object)
-	return createdResource != nil, err
+	liveService, isLiveService := liveObject.(*api.Service)
+	service, isService := object.(*api.Service)
+	if isLiveService && isService && len(service.Spec.ClusterIP) == 0 {
+		service.Spec.ClusterIP = liveService.Spec.ClusterIP
+	}
+	return nil
 }
 
-// DeployAppFromFile deploys an app based on the given yaml or json file.
+// DeployAppFromFile deploys an app based on the given yaml or json file. Every object from the
+// file is deployed even when some of them fail. Returns results for each object and aggregated
+// errors of all of them.
 func DeployAppFromFile(spec *AppDeploymentFromFileSpec,
-	createObjectFromInfoFn createObjectFromInfo, clientConfig clientcmd.ClientConfig) (bool, error) {
+	deployObjectFromInfoFn deployObjectFromInfo, clientConfig clientcmd.ClientConfig) (
+	[]ObjectDeploymentResult, error) {
 	const (
 		validate      = true
 		emptyCacheDir = ""
 	)
 
+	results := make([]ObjectDeploymentResult, 0)
+
+	mode := spec.Mode
+	if mode == "" {
+		mode = CreateMode
+	}
+	if mode != CreateMode && mode != ApplyMode {
+		return results, fmt.Errorf("Unsupported mode of deployment from file: %s", mode)
+	}
+
 	factory := cmdutil.NewFactory(clientConfig)
 	schema, err := factory.Validator(validate, emptyCacheDir)
 	if err != nil {
-		return false, err
+		return results, err
 	}
 
 	mapper, typer := factory.Object()
 	reader := strings.NewReader(spec.Content)
 
 	r := kubectlResource.NewBuilder(mapper, typer, kubectlResource.ClientMapperFunc(factory.ClientForMapping), factory.Decoder(true)).
+		ContinueOnError().
 		Schema(schema).
 		NamespaceParam(api.NamespaceDefault).DefaultNamespace().
 		Stream(reader, spec.Name).
 		Flatten().
 		Do()
 
-	deployedResourcesCount := 0
-
+	errs := []error{}
 	err = r.Visit(func(info *kubectlResource.Info, err error) error {
-		isDeployed, err := createObjectFromInfoFn(info)
-		if isDeployed {
-			deployedResourcesCount++
-			log.Printf("%s is deployed", info.Name)
+		if err != nil {
+			results = append(results, ObjectDeploymentResult{Status: ObjectFailed, Error: err.Error()})
+			errs = append(errs, err)
+			return nil
 		}
-		return err
-	})
 
-	return deployedResourcesCount > 0, err
+		result := ObjectDeploymentResult{
+			Kind:      info.Mapping.GroupVersionKind.Kind,
+			Namespace: info.Namespace,
+			Name:      info.Name,
+		}
+		status, err := deployObjectFromInfoFn(info, mode, spec.DryRun)
+		result.Status = status
+		if err != nil {
+			result.Status = ObjectFailed
+			result.Error = err.Error()
+			errs = append(errs, err)
+		} else {
+			log.Printf("%s is %s", info.Name, status)
+		}
+		results = append(results, result)
+
+		return nil
+	})
+	// Remaining errors come from decoding or validation of the file, when no object is available.
+	// Each of them is reported as a separate failed entry.
+	if err != nil {
+		builderErrs := []error{err}
+		if aggregate, ok := err.(utilerrors.Aggregate); ok {
+			builderErrs = aggregate.Errors()
+		}
+		for _, builderErr := range builderErrs {
+			results = append(results, ObjectDeploymentResult{
+				Status: ObjectFailed,
+				Error:  builderErr.Error(),
+			})
+			errs = append(errs, builderErr)
+		}
+	}
+	if len(errs) == 0 && len(results) == 0 {
+		errs = append(errs, fmt.Errorf("No objects to deploy found in %s", spec.Name))
+	}
+	if len(errs) == 1 {
+		return results, errs[0]
+	}
+	return results, utilerrors.NewAggregate(errs)
 }
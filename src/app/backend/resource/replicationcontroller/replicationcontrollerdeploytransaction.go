@@ -0,0 +1,240 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicationcontroller
+
+import (
+	"log"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// AppDeploymentReport describes objects touched while deploying an app.
+type AppDeploymentReport struct {
+	// Objects that were created, in creation order.
+	Created []DeployedObject `json:"created"`
+
+	// Objects that were deleted because the deployment failed at a later step.
+	RolledBack []DeployedObject `json:"rolledBack"`
+
+	// Objects that could not be deleted when the deployment failed. They have to be cleaned up
+	// manually.
+	RollbackFailed []DeployedObject `json:"rollbackFailed"`
+
+	// Error that made the deployment fail. Empty when the deployment succeeded.
+	Error string `json:"error"`
+}
+
+// DeployedObject identifies an object created by an app deployment.
+type DeployedObject struct {
+	// Kind of the object, e.g., Service.
+	Kind string `json:"kind"`
+
+	// Name of the object.
+	Name string `json:"name"`
+
+	// Namespace of the object.
+	Namespace string `json:"namespace"`
+
+	// Error that occurred when rolling back the object, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Keeps track of objects created while deploying an app, so that they can be deleted when a
+// later step fails.
+type appDeploymentTransaction struct {
+	client    client.Interface
+	namespace string
+	report    *AppDeploymentReport
+
+	// Functions that delete created objects, in creation order.
+	deleteFns []func() error
+}
+
+func newAppDeploymentTransaction(client client.Interface,
+	namespace string) *appDeploymentTransaction {
+
+	return &appDeploymentTransaction{
+		client:    client,
+		namespace: namespace,
+		report: &AppDeploymentReport{
+			Created:        make([]DeployedObject, 0),
+			RolledBack:     make([]DeployedObject, 0),
+			RollbackFailed: make([]DeployedObject, 0),
+		},
+	}
+}
+
+// Creates an object with the given function and, when it succeeds, records it together with the
+// function that deletes it.
+func (t *appDeploymentTransaction) create(kind, name string, createFn func() error,
+	deleteFn func() error) error {
+
+	if err := createFn(); err != nil {
+		return err
+	}
+
+	t.report.Created = append(t.report.Created, DeployedObject{
+		Kind:      kind,
+		Name:      name,
+		Namespace: t.namespace,
+	})
+	t.deleteFns = append(t.deleteFns, deleteFn)
+	return nil
+}
+
+// Deletes all objects created so far, newest first, and returns the report together with the
+// given error that caused the rollback.
+func (t *appDeploymentTransaction) rollback(err error) (*AppDeploymentReport, error) {
+	log.Printf("Rolling back app deployment in %s namespace because of error: %s", t.namespace,
+		err)
+
+	t.report.Error = err.Error()
+	for i := len(t.deleteFns) - 1; i >= 0; i-- {
+		object := t.report.Created[i]
+		if deleteErr := t.deleteFns[i](); deleteErr != nil {
+			log.Printf("Cannot roll back %s %s: %s", object.Kind, object.Name, deleteErr)
+			object.Error = deleteErr.Error()
+			t.report.RollbackFailed = append(t.report.RollbackFailed, object)
+		} else {
+			t.report.RolledBack = append(t.report.RolledBack, object)
+		}
+	}
+
+	return t.report, err
+}
+
+func (t *appDeploymentTransaction) createReplicationController(
+	replicationController *api.ReplicationController) error {
+
+	return t.create("ReplicationController", replicationController.Name,
+		func() error {
+			_, err := t.client.ReplicationControllers(t.namespace).Create(replicationController)
+			return err
+		},
+		func() error {
+			return DeleteReplicationController(t.client, t.namespace, replicationController.Name,
+				false)
+		})
+}
+
+func (t *appDeploymentTransaction) createDeployment(deployment *extensions.Deployment) error {
+	return t.create("Deployment", deployment.Name,
+		func() error {
+			_, err := t.client.Extensions().Deployments(t.namespace).Create(deployment)
+			return err
+		},
+		func() error {
+			err := t.client.Extensions().Deployments(t.namespace).Delete(deployment.Name,
+				&api.DeleteOptions{})
+			if err != nil {
+				return err
+			}
+			if err := t.deleteReplicaSets(deployment.Spec.Selector.MatchLabels); err != nil {
+				return err
+			}
+			return t.deletePods(deployment.Spec.Selector.MatchLabels)
+		})
+}
+
+func (t *appDeploymentTransaction) createDaemonSet(daemonSet *extensions.DaemonSet) error {
+	return t.create("DaemonSet", daemonSet.Name,
+		func() error {
+			_, err := t.client.Extensions().DaemonSets(t.namespace).Create(daemonSet)
+			return err
+		},
+		func() error {
+			if err := t.client.Extensions().DaemonSets(t.namespace).Delete(daemonSet.Name); err != nil {
+				return err
+			}
+			return t.deletePods(daemonSet.Spec.Selector.MatchLabels)
+		})
+}
+
+func (t *appDeploymentTransaction) createJob(job *extensions.Job) error {
+	return t.create("Job", job.Name,
+		func() error {
+			_, err := t.client.Batch().Jobs(t.namespace).Create(job)
+			return err
+		},
+		func() error {
+			if err := t.client.Batch().Jobs(t.namespace).Delete(job.Name, &api.DeleteOptions{}); err != nil {
+				return err
+			}
+			return t.deletePods(job.Spec.Template.Labels)
+		})
+}
+
+func (t *appDeploymentTransaction) createService(service *api.Service) error {
+	return t.create("Service", service.Name,
+		func() error {
+			_, err := t.client.Services(t.namespace).Create(service)
+			return err
+		},
+		func() error {
+			return t.client.Services(t.namespace).Delete(service.Name)
+		})
+}
+
+// Deletes replica sets that a rolled back deployment managed to create. Nothing is deleted for
+// empty selector, as it would match all replica sets in the namespace.
+func (t *appDeploymentTransaction) deleteReplicaSets(selector map[string]string) error {
+	if len(selector) == 0 {
+		return nil
+	}
+
+	replicaSets, err := t.client.Extensions().ReplicaSets(t.namespace).List(api.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, replicaSet := range replicaSets.Items {
+		err := t.client.Extensions().ReplicaSets(t.namespace).Delete(replicaSet.Name,
+			&api.DeleteOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deletes pods that a rolled back controller managed to create. Nothing is deleted for empty
+// selector, as it would match all pods in the namespace.
+func (t *appDeploymentTransaction) deletePods(selector map[string]string) error {
+	if len(selector) == 0 {
+		return nil
+	}
+
+	pods, err := t.client.Pods(t.namespace).List(api.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		if err := t.client.Pods(t.namespace).Delete(pod.Name, &api.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
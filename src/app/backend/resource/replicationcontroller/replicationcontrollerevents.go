@@ -113,21 +113,3 @@ func GetReplicationControllerPodsEvents(client *client.Client, namespace, replic
 
 	return events, nil
 }
-
-// AppendEvents appends events from source slice to target events representation.
-func AppendEvents(source []api.Event, target Events) Events {
-	for _, event := range source {
-		target.Events = append(target.Events, Event{
-			Message:         event.Message,
-			SourceComponent: event.Source.Component,
-			SourceHost:      event.Source.Host,
-			SubObject:       event.InvolvedObject.FieldPath,
-			Count:           event.Count,
-			FirstSeen:       event.FirstTimestamp,
-			LastSeen:        event.LastTimestamp,
-			Reason:          event.Reason,
-			Type:            event.Type,
-		})
-	}
-	return target
-}
@@ -25,7 +25,9 @@ import (
 
 // ReplicationControllerList contains a list of Replication Controllers in the cluster.
 type ReplicationControllerList struct {
-	// Unordered list of Replication Controllers.
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of Replication Controllers.
 	ReplicationControllers []ReplicationController `json:"replicationControllers"`
 }
 
@@ -48,25 +50,28 @@ type ReplicationController struct {
 	ExternalEndpoints []common.Endpoint `json:"externalEndpoints"`
 }
 
-// GetReplicationControllerList returns a list of all Replication Controllers in the cluster.
-func GetReplicationControllerList(client *client.Client) (*ReplicationControllerList, error) {
-	log.Printf("Getting list of all replication controllers in the cluster")
+// GetReplicationControllerList returns a list of Replication Controllers in the given namespace,
+// or in all namespaces when it is api.NamespaceAll.
+func GetReplicationControllerList(client *client.Client, namespace string,
+	dsQuery *common.DataSelectQuery) (*ReplicationControllerList, error) {
+	log.Printf("Getting list of replication controllers in %s",
+		common.DescribeNamespace(namespace))
 
 	channels := &common.ResourceChannels{
-		ReplicationControllerList: common.GetReplicationControllerListChannel(client, 1),
-		ServiceList:               common.GetServiceListChannel(client, 1),
-		PodList:                   common.GetPodListChannel(client, 1),
-		EventList:                 common.GetEventListChannel(client, 1),
+		ReplicationControllerList: common.GetReplicationControllerListChannel(client, namespace, 1),
+		ServiceList:               common.GetServiceListChannel(client, namespace, 1),
+		PodList:                   common.GetPodListChannel(client, namespace, 1),
+		EventList:                 common.GetEventListChannel(client, namespace, 1),
 		NodeList:                  common.GetNodeListChannel(client, 1),
 	}
 
-	return GetReplicationControllerListFromChannels(channels)
+	return GetReplicationControllerListFromChannels(channels, dsQuery)
 }
 
 // GetReplicationControllerList returns a list of all Replication Controllers in the cluster
 // reading required resource list once from the channels.
-func GetReplicationControllerListFromChannels(channels *common.ResourceChannels) (
-	*ReplicationControllerList, error) {
+func GetReplicationControllerListFromChannels(channels *common.ResourceChannels,
+	dsQuery *common.DataSelectQuery) (*ReplicationControllerList, error) {
 
 	replicationControllers := <-channels.ReplicationControllerList.List
 	if err := <-channels.ReplicationControllerList.Error; err != nil {
@@ -94,7 +99,7 @@ func GetReplicationControllerListFromChannels(channels *common.ResourceChannels)
 	}
 
 	result := getReplicationControllerList(replicationControllers.Items, services.Items,
-		pods.Items, events.Items, nodes.Items)
+		pods.Items, events.Items, nodes.Items, dsQuery)
 
 	return result, nil
 }
@@ -104,9 +109,11 @@ func GetReplicationControllerListFromChannels(channels *common.ResourceChannels)
 // The function processes all Replication Controllers API objects and finds matching Services for them.
 func getReplicationControllerList(replicationControllers []api.ReplicationController,
 	services []api.Service, pods []api.Pod, events []api.Event,
-	nodes []api.Node) *ReplicationControllerList {
+	nodes []api.Node, dsQuery *common.DataSelectQuery) *ReplicationControllerList {
 
+	replicationControllers, listMeta := selectReplicationControllers(replicationControllers, dsQuery)
 	replicationControllerList := &ReplicationControllerList{
+		ListMeta:               listMeta,
 		ReplicationControllers: make([]ReplicationController, 0),
 	}
 
@@ -138,7 +145,7 @@ func getReplicationControllerList(replicationControllers []api.ReplicationContro
 				ObjectMeta:        common.CreateObjectMeta(replicationController.ObjectMeta),
 				TypeMeta:          common.CreateTypeMeta(replicationController.TypeMeta),
 				Pods:              podInfo,
-				ContainerImages:   GetContainerImages(&replicationController.Spec.Template.Spec),
+				ContainerImages:   common.GetContainerImages(&replicationController.Spec.Template.Spec),
 				InternalEndpoints: internalEndpoints,
 				ExternalEndpoints: externalEndpoints,
 			})
@@ -161,3 +168,32 @@ func getMatchingServices(services []api.Service,
 	}
 	return matchingServices
 }
+
+// Replication Controller list item that data select queries are applied to.
+type replicationControllerCell api.ReplicationController
+
+func (r replicationControllerCell) GetObjectMeta() api.ObjectMeta {
+	return r.ObjectMeta
+}
+
+func (r replicationControllerCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	return nil
+}
+
+// Returns Replication Controllers selected by the query together with metadata of the whole
+// filtered list.
+func selectReplicationControllers(replicationControllers []api.ReplicationController,
+	dsQuery *common.DataSelectQuery) ([]api.ReplicationController, common.ListMeta) {
+
+	cells := make([]common.DataCell, len(replicationControllers))
+	for i := range replicationControllers {
+		cells[i] = replicationControllerCell(replicationControllers[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]api.ReplicationController, len(cells))
+	for i := range cells {
+		result[i] = api.ReplicationController(cells[i].(replicationControllerCell))
+	}
+	return result, listMeta
+}
@@ -15,7 +15,11 @@
 package secret
 
 import (
+	"fmt"
+	"log"
+
 	api "k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -27,6 +31,7 @@ type SecretSpec interface {
 	GetType() api.SecretType
 	GetNamespace() string
 	GetData() map[string][]byte
+	GetAnnotations() map[string]string
 }
 
 // ImagePullSecretSpec - specification of an image pull secret
@@ -60,6 +65,57 @@ func (spec *ImagePullSecretSpec) GetData() map[string][]byte {
 	return map[string][]byte{api.DockerConfigKey: spec.Data}
 }
 
+// GetAnnotations - return the annotations of the ImagePullSecret, it has none
+func (spec *ImagePullSecretSpec) GetAnnotations() map[string]string {
+	return nil
+}
+
+// GenericSecretSpec - specification of an Opaque, TLS or service account token secret
+// implements SecretSpec
+type GenericSecretSpec struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Type of the secret. Opaque when empty.
+	Type api.SecretType `json:"type"`
+	// Name of the service account the token belongs to. Used by service account token secrets
+	// only.
+	ServiceAccountName string `json:"serviceAccountName"`
+	// Key-value pairs, e.g., uploaded files keyed by their names. Values must be Base64 encoded.
+	Data map[string][]byte `json:"data"`
+}
+
+// GetName - return the name of the secret
+func (spec *GenericSecretSpec) GetName() string {
+	return spec.Name
+}
+
+// GetType - return the type of the secret, api.SecretTypeOpaque by default
+func (spec *GenericSecretSpec) GetType() api.SecretType {
+	if spec.Type == "" {
+		return api.SecretTypeOpaque
+	}
+	return spec.Type
+}
+
+// GetNamespace - return the namespace of the secret
+func (spec *GenericSecretSpec) GetNamespace() string {
+	return spec.Namespace
+}
+
+// GetData - return the data the secret carries
+func (spec *GenericSecretSpec) GetData() map[string][]byte {
+	return spec.Data
+}
+
+// GetAnnotations - return the annotations of the secret, service account token secrets are
+// annotated with the name of their service account
+func (spec *GenericSecretSpec) GetAnnotations() map[string]string {
+	if spec.GetType() != api.SecretTypeServiceAccountToken {
+		return nil
+	}
+	return map[string]string{api.ServiceAccountNameKey: spec.ServiceAccountName}
+}
+
 // Secret - a single secret returned to the frontend.
 type Secret struct {
 	Name string `json:"name"`
@@ -71,7 +127,7 @@ type SecretsList struct {
 }
 
 // GetSecrets - return all secrets in the given namespace.
-func GetSecrets(client *client.Client, namespace string) (*SecretsList,
+func GetSecrets(client client.Interface, namespace string) (*SecretsList,
 	error) {
 	secretsList := &SecretsList{}
 	secrets, err := client.Secrets(namespace).List(api.ListOptions{
@@ -88,16 +144,88 @@ func GetSecrets(client *client.Client, namespace string) (*SecretsList,
 }
 
 // CreateSecret - create a single secret using the cluster API client
-func CreateSecret(client *client.Client, spec SecretSpec) (*Secret, error) {
+func CreateSecret(client client.Interface, spec SecretSpec) (*Secret, error) {
 	namespace := spec.GetNamespace()
 	secret := &api.Secret{
 		ObjectMeta: api.ObjectMeta{
-			Name:      spec.GetName(),
-			Namespace: namespace,
+			Name:        spec.GetName(),
+			Namespace:   namespace,
+			Annotations: spec.GetAnnotations(),
 		},
 		Type: spec.GetType(),
 		Data: spec.GetData(),
 	}
+	if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
 	_, err := client.Secrets(namespace).Create(secret)
 	return &Secret{Name: secret.ObjectMeta.Name}, err
 }
+
+// UpdateSecret - replace data of the secret with the given name. Type of the secret
+// cannot be changed.
+func UpdateSecret(client client.Interface, namespace, name string, spec SecretSpec) (*Secret,
+	error) {
+	log.Printf("Updating %s secret in %s namespace", name, namespace)
+
+	secret, err := client.Secrets(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if spec.GetType() != secret.Type {
+		return nil, k8serrors.NewBadRequest(fmt.Sprintf(
+			"cannot change type of %s secret from %s to %s", name, secret.Type, spec.GetType()))
+	}
+
+	secret.Data = spec.GetData()
+	for key, value := range spec.GetAnnotations() {
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[key] = value
+	}
+	if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
+
+	_, err = client.Secrets(namespace).Update(secret)
+	return &Secret{Name: name}, err
+}
+
+// DeleteSecret - delete the secret with the given name
+func DeleteSecret(client client.Interface, namespace, name string) error {
+	log.Printf("Deleting %s secret in %s namespace", name, namespace)
+	return client.Secrets(namespace).Delete(name)
+}
+
+// Checks that the secret has a name and carries the keys required by its type. Returns bad
+// request error otherwise.
+func validateSecret(secret *api.Secret) error {
+	if len(secret.Name) == 0 {
+		return k8serrors.NewBadRequest("secret name is required")
+	}
+
+	var requiredKeys []string
+	switch secret.Type {
+	case api.SecretTypeOpaque:
+	case api.SecretTypeDockercfg:
+		requiredKeys = []string{api.DockerConfigKey}
+	case api.SecretTypeTLS:
+		requiredKeys = []string{api.TLSCertKey, api.TLSPrivateKeyKey}
+	case api.SecretTypeServiceAccountToken:
+		if len(secret.Annotations[api.ServiceAccountNameKey]) == 0 {
+			return k8serrors.NewBadRequest("service account name is required for " +
+				"service account token secrets")
+		}
+	default:
+		return k8serrors.NewBadRequest(fmt.Sprintf("unsupported secret type: %s", secret.Type))
+	}
+
+	for _, key := range requiredKeys {
+		if _, ok := secret.Data[key]; !ok {
+			return k8serrors.NewBadRequest(fmt.Sprintf("%s key is required for %s secrets", key,
+				secret.Type))
+		}
+	}
+	return nil
+}
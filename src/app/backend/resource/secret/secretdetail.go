@@ -0,0 +1,183 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"log"
+	"sort"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	api "k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// SecretUsage - the way a pod uses a secret
+type SecretUsage string
+
+const (
+	// VolumeUsage - the secret is mounted as a volume
+	VolumeUsage SecretUsage = "volume"
+	// EnvUsage - a key of the secret is exposed as an environment variable
+	EnvUsage SecretUsage = "env"
+	// ImagePullUsage - the secret is used to pull images of the pod
+	ImagePullUsage SecretUsage = "imagePull"
+)
+
+// SecretDetail - detailed view of a secret. Values of the secret are hidden, they can be
+// retrieved one at a time with GetSecretValue.
+type SecretDetail struct {
+	ObjectMeta common.ObjectMeta `json:"objectMeta"`
+	TypeMeta   common.TypeMeta   `json:"typeMeta"`
+
+	// Type of the secret, e.g., Opaque or kubernetes.io/tls.
+	Type api.SecretType `json:"type"`
+
+	// Keys of the secret sorted by name.
+	Keys []SecretKey `json:"keys"`
+
+	// Pods in the namespace of the secret that mount or reference it.
+	Pods []SecretPodReference `json:"pods"`
+}
+
+// SecretKey - a single key of the secret without its value
+type SecretKey struct {
+	Name string `json:"name"`
+	// Size of the value in bytes.
+	Size int `json:"size"`
+}
+
+// SecretPodReference - a pod that uses the secret
+type SecretPodReference struct {
+	Name string `json:"name"`
+	// Ways in which the pod uses the secret.
+	Usages []SecretUsage `json:"usages"`
+}
+
+// SecretValue - a single revealed value of the secret
+type SecretValue struct {
+	Key string `json:"key"`
+	// The value, Base64 encoded when serialized.
+	Value []byte `json:"value"`
+}
+
+// GetSecretDetail - return keys of the secret and the pods that use it
+func GetSecretDetail(client client.Interface, namespace, name string) (*SecretDetail, error) {
+	log.Printf("Getting details of %s secret in %s namespace", name, namespace)
+
+	secret, err := client.Secrets(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.Pods(namespace).List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretDetail{
+		ObjectMeta: common.CreateObjectMeta(secret.ObjectMeta),
+		TypeMeta:   common.CreateTypeMeta(secret.TypeMeta),
+		Type:       secret.Type,
+		Keys:       getSecretKeys(secret.Data),
+		Pods:       getSecretPods(secret.Name, pods.Items),
+	}, nil
+}
+
+// GetSecretValue - return value of the given key of the secret
+func GetSecretValue(client client.Interface, namespace, name, key string) (*SecretValue, error) {
+	log.Printf("Getting %s key of %s secret in %s namespace", key, name, namespace)
+
+	secret, err := client.Secrets(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := secret.Data[key]
+	if !ok {
+		return nil, k8serrors.NewNotFound(unversioned.GroupResource{Resource: "secrets"},
+			name+"/"+key)
+	}
+	return &SecretValue{Key: key, Value: value}, nil
+}
+
+// Returns keys of the secret data with sizes of their values, sorted by name.
+func getSecretKeys(data map[string][]byte) []SecretKey {
+	keys := make([]SecretKey, 0)
+	for name, value := range data {
+		keys = append(keys, SecretKey{Name: name, Size: len(value)})
+	}
+	sort.Sort(secretKeysByName(keys))
+	return keys
+}
+
+type secretKeysByName []SecretKey
+
+func (s secretKeysByName) Len() int           { return len(s) }
+func (s secretKeysByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s secretKeysByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+// Returns pods that use the secret with the given name, together with the ways they use it.
+func getSecretPods(secretName string, pods []api.Pod) []SecretPodReference {
+	references := make([]SecretPodReference, 0)
+	for _, pod := range pods {
+		if usages := getSecretUsages(secretName, pod); len(usages) > 0 {
+			references = append(references, SecretPodReference{Name: pod.Name, Usages: usages})
+		}
+	}
+	return references
+}
+
+// Returns ways in which the pod uses the secret with the given name. Empty when it does not.
+func getSecretUsages(secretName string, pod api.Pod) []SecretUsage {
+	var usages []SecretUsage
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == secretName {
+			usages = append(usages, VolumeUsage)
+			break
+		}
+	}
+
+	if isSecretInEnv(secretName, pod.Spec.Containers) {
+		usages = append(usages, EnvUsage)
+	}
+
+	for _, reference := range pod.Spec.ImagePullSecrets {
+		if reference.Name == secretName {
+			usages = append(usages, ImagePullUsage)
+			break
+		}
+	}
+	return usages
+}
+
+// Returns true when any of the containers exposes a key of the secret as environment variable.
+func isSecretInEnv(secretName string, containers []api.Container) bool {
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil &&
+				env.ValueFrom.SecretKeyRef.Name == secretName {
+				return true
+			}
+		}
+	}
+	return false
+}
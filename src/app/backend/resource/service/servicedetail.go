@@ -18,9 +18,14 @@ import (
 	"log"
 
 	"k8s.io/kubernetes/pkg/api"
-	client "k8s.io/kubernetes/pkg/client/unversioned"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	k8sClient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 
+	"github.com/kubernetes/dashboard/client"
 	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/pod"
 )
 
 // Service is a representation of a service.
@@ -45,10 +50,33 @@ type ServiceDetail struct {
 	// ClusterIP is usually assigned by the master. Valid values are None, empty string (""), or
 	// a valid IP address. None can be specified for headless services when proxying is not required
 	ClusterIP string `json:"clusterIP"`
+
+	// Addresses that receive traffic of the service, ready or not.
+	Endpoints []ServiceEndpoint `json:"endpoints"`
+
+	// Pods selected by the label selector of the service.
+	Pods pod.PodList `json:"pods"`
+}
+
+// ServiceEndpoint is a single address that the service routes traffic to.
+type ServiceEndpoint struct {
+	// IP address of the endpoint.
+	Host string `json:"host"`
+
+	// Ports of the endpoint.
+	Ports []api.EndpointPort `json:"ports"`
+
+	// Whether the endpoint receives traffic. Endpoints are not ready, e.g., when their pods fail
+	// readiness probes.
+	Ready bool `json:"ready"`
+
+	// Object that the endpoint belongs to, usually a pod. Nil when it is not known.
+	TargetRef *api.ObjectReference `json:"targetRef"`
 }
 
-// GetServiceDetail gets service details.
-func GetServiceDetail(client client.Interface, namespace, name string) (*ServiceDetail, error) {
+// GetServiceDetail gets service details, together with its endpoints and the pods it selects.
+func GetServiceDetail(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace, name string) (*ServiceDetail, error) {
 	log.Printf("Getting details of %s service in %s namespace", name, namespace)
 
 	// TODO(maciaszczykm): Use channels.
@@ -57,6 +85,89 @@ func GetServiceDetail(client client.Interface, namespace, name string) (*Service
 		return nil, err
 	}
 
+	endpoints, err := client.Endpoints(namespace).Get(name)
+	if k8serrors.IsNotFound(err) {
+		// Services without selector have no endpoints until they are created manually.
+		endpoints = &api.Endpoints{}
+	} else if err != nil {
+		return nil, err
+	}
+
+	pods, err := getServicePods(client, *serviceData)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := getServiceNodes(client, *serviceData)
+	if err != nil {
+		return nil, err
+	}
+
 	service := ToServiceDetail(serviceData)
+	service.ExternalEndpoints = common.GetExternalEndpoints(pods, *serviceData, nodes)
+	service.Endpoints = getServiceEndpoints(*endpoints)
+	service.Pods = pod.CreatePodList(pods, common.NoDataSelect, heapsterClient)
 	return &service, nil
 }
+
+// Returns pods selected by the label selector of the service. Services without selector select
+// no pods.
+func getServicePods(client k8sClient.Interface, service api.Service) ([]api.Pod, error) {
+	if len(service.Spec.Selector) == 0 {
+		return []api.Pod{}, nil
+	}
+
+	pods, err := client.Pods(service.Namespace).List(api.ListOptions{
+		LabelSelector: labels.SelectorFromSet(service.Spec.Selector),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// Returns nodes that can expose the service on their ports. Only node port and load balancer
+// services are exposed on nodes.
+func getServiceNodes(client k8sClient.Interface, service api.Service) ([]api.Node, error) {
+	if service.Spec.Type != api.ServiceTypeNodePort &&
+		service.Spec.Type != api.ServiceTypeLoadBalancer {
+		return []api.Node{}, nil
+	}
+
+	nodes, err := client.Nodes().List(api.ListOptions{
+		LabelSelector: labels.Everything(),
+		FieldSelector: fields.Everything(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nodes.Items, nil
+}
+
+// Returns ready and not ready addresses of the endpoints, ready ones first.
+func getServiceEndpoints(endpoints api.Endpoints) []ServiceEndpoint {
+	result := make([]ServiceEndpoint, 0)
+	for _, subset := range endpoints.Subsets {
+		for _, address := range subset.Addresses {
+			result = append(result, toServiceEndpoint(address, subset.Ports, true))
+		}
+	}
+	for _, subset := range endpoints.Subsets {
+		for _, address := range subset.NotReadyAddresses {
+			result = append(result, toServiceEndpoint(address, subset.Ports, false))
+		}
+	}
+	return result
+}
+
+func toServiceEndpoint(address api.EndpointAddress, ports []api.EndpointPort,
+	ready bool) ServiceEndpoint {
+
+	return ServiceEndpoint{
+		Host:      address.IP,
+		Ports:     ports,
+		Ready:     ready,
+		TargetRef: address.TargetRef,
+	}
+}
@@ -17,6 +17,7 @@ package service
 import (
 	"log"
 
+	"k8s.io/kubernetes/pkg/api"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 
 	"github.com/kubernetes/dashboard/resource/common"
@@ -45,16 +46,20 @@ type Service struct {
 
 // ServiceList contains a list of services in the cluster.
 type ServiceList struct {
-	// Unordered list of services.
+	ListMeta common.ListMeta `json:"listMeta"`
+
+	// Selected page of services.
 	Services []Service `json:"services"`
 }
 
-// GetServiceList returns a list of all services in the cluster.
-func GetServiceList(client client.Interface) (*ServiceList, error) {
-	log.Printf("Getting list of all services in the cluster")
+// GetServiceList returns a list of services in the given namespace, or in all namespaces when it
+// is api.NamespaceAll.
+func GetServiceList(client client.Interface, namespace string,
+	dsQuery *common.DataSelectQuery) (*ServiceList, error) {
+	log.Printf("Getting list of services in %s", common.DescribeNamespace(namespace))
 
 	channels := &common.ResourceChannels{
-		ServiceList: common.GetServiceListChannel(client, 1),
+		ServiceList: common.GetServiceListChannel(client, namespace, 1),
 	}
 
 	services := <-channels.ServiceList.List
@@ -62,10 +67,39 @@ func GetServiceList(client client.Interface) (*ServiceList, error) {
 		return nil, err
 	}
 
-	serviceList := &ServiceList{Services: make([]Service, 0)}
-	for _, service := range services.Items {
+	selectedServices, listMeta := selectServices(services.Items, dsQuery)
+	serviceList := &ServiceList{ListMeta: listMeta, Services: make([]Service, 0)}
+	for _, service := range selectedServices {
 		serviceList.Services = append(serviceList.Services, ToService(&service))
 	}
 
 	return serviceList, nil
 }
+
+// Service list item that data select queries are applied to.
+type serviceCell api.Service
+
+func (s serviceCell) GetObjectMeta() api.ObjectMeta {
+	return s.ObjectMeta
+}
+
+func (s serviceCell) GetProperty(name common.PropertyName) common.ComparableValue {
+	return nil
+}
+
+// Returns services selected by the query together with metadata of the whole filtered list.
+func selectServices(services []api.Service, dsQuery *common.DataSelectQuery) ([]api.Service,
+	common.ListMeta) {
+
+	cells := make([]common.DataCell, len(services))
+	for i := range services {
+		cells[i] = serviceCell(services[i])
+	}
+
+	cells, listMeta := common.SelectData(cells, dsQuery)
+	result := make([]api.Service, len(cells))
+	for i := range cells {
+		result[i] = api.Service(cells[i].(serviceCell))
+	}
+	return result, listMeta
+}
@@ -19,7 +19,9 @@ import (
 
 	"github.com/kubernetes/dashboard/client"
 	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/daemonset"
 	"github.com/kubernetes/dashboard/resource/deployment"
+	"github.com/kubernetes/dashboard/resource/job"
 	"github.com/kubernetes/dashboard/resource/pod"
 	"github.com/kubernetes/dashboard/resource/replicaset"
 	"github.com/kubernetes/dashboard/resource/replicationcontroller"
@@ -34,62 +36,83 @@ type Workloads struct {
 
 	ReplicationControllerList replicationcontroller.ReplicationControllerList `json:"replicationControllerList"`
 
+	JobList job.JobList `json:"jobList"`
+
+	DaemonSetList daemonset.DaemonSetList `json:"daemonSetList"`
+
 	PodList pod.PodList `json:"podList"`
 }
 
-// GetWorkloads returns a list of all workloads in the cluster.
-func GetWorkloads(client k8sClient.Interface,
-	heapsterClient client.HeapsterClient) (*Workloads, error) {
+// GetWorkloads returns a list of workloads in the given namespace, or in all namespaces when it is
+// api.NamespaceAll.
+func GetWorkloads(client k8sClient.Interface, heapsterClient client.HeapsterClient,
+	namespace string, dsQuery *common.DataSelectQuery) (*Workloads, error) {
 
-	log.Printf("Getting lists of all workloads")
+	log.Printf("Getting lists of workloads in %s", common.DescribeNamespace(namespace))
 	channels := &common.ResourceChannels{
-		ReplicationControllerList: common.GetReplicationControllerListChannel(client, 1),
-		ReplicaSetList:            common.GetReplicaSetListChannel(client.Extensions(), 1),
-		DeploymentList:            common.GetDeploymentListChannel(client.Extensions(), 1),
-		ServiceList:               common.GetServiceListChannel(client, 3),
-		PodList:                   common.GetPodListChannel(client, 4),
-		EventList:                 common.GetEventListChannel(client, 3),
+		ReplicationControllerList: common.GetReplicationControllerListChannel(client, namespace, 1),
+		ReplicaSetList:            common.GetReplicaSetListChannel(client.Extensions(), namespace, 1),
+		DeploymentList:            common.GetDeploymentListChannel(client.Extensions(), namespace, 1),
+		JobList:                   common.GetJobListChannel(client.Batch(), namespace, 1),
+		DaemonSetList:             common.GetDaemonSetListChannel(client.Extensions(), namespace, 1),
+		ServiceList:               common.GetServiceListChannel(client, namespace, 3),
+		PodList:                   common.GetPodListChannel(client, namespace, 6),
+		EventList:                 common.GetEventListChannel(client, namespace, 5),
 		NodeList:                  common.GetNodeListChannel(client, 3),
 	}
 
-	return GetWorkloadsFromChannels(channels, heapsterClient)
+	return GetWorkloadsFromChannels(channels, heapsterClient, dsQuery)
 }
 
 // GetWorkloadsFromChannels returns a list of all workloads in the cluster, from the
-// channel sources.
+// channel sources. The data select query is applied to each of the lists separately.
 func GetWorkloadsFromChannels(channels *common.ResourceChannels,
-	heapsterClient client.HeapsterClient) (*Workloads, error) {
+	heapsterClient client.HeapsterClient, dsQuery *common.DataSelectQuery) (*Workloads, error) {
 
 	rsChan := make(chan *replicaset.ReplicaSetList)
 	deploymentChan := make(chan *deployment.DeploymentList)
 	rcChan := make(chan *replicationcontroller.ReplicationControllerList)
 	podChan := make(chan *pod.PodList)
-	errChan := make(chan error, 4)
+	jobChan := make(chan *job.JobList)
+	daemonSetChan := make(chan *daemonset.DaemonSetList)
+	errChan := make(chan error, 6)
 
 	go func() {
-		rcList, err := replicationcontroller.GetReplicationControllerListFromChannels(channels)
+		rcList, err := replicationcontroller.GetReplicationControllerListFromChannels(channels, dsQuery)
 		errChan <- err
 		rcChan <- rcList
 	}()
 
 	go func() {
-		rsList, err := replicaset.GetReplicaSetListFromChannels(channels)
+		rsList, err := replicaset.GetReplicaSetListFromChannels(channels, dsQuery)
 		errChan <- err
 		rsChan <- rsList
 	}()
 
 	go func() {
-		deploymentList, err := deployment.GetDeploymentListFromChannels(channels)
+		deploymentList, err := deployment.GetDeploymentListFromChannels(channels, dsQuery)
 		errChan <- err
 		deploymentChan <- deploymentList
 	}()
 
 	go func() {
-		podList, err := pod.GetPodListFromChannels(channels, heapsterClient)
+		podList, err := pod.GetPodListFromChannels(channels, dsQuery, heapsterClient)
 		errChan <- err
 		podChan <- podList
 	}()
 
+	go func() {
+		jobList, err := job.GetJobListFromChannels(channels, dsQuery)
+		errChan <- err
+		jobChan <- jobList
+	}()
+
+	go func() {
+		daemonSetList, err := daemonset.GetDaemonSetListFromChannels(channels, dsQuery)
+		errChan <- err
+		daemonSetChan <- daemonSetList
+	}()
+
 	rcList := <-rcChan
 	err := <-errChan
 	if err != nil {
@@ -114,11 +137,25 @@ func GetWorkloadsFromChannels(channels *common.ResourceChannels,
 		return nil, err
 	}
 
+	jobList := <-jobChan
+	err = <-errChan
+	if err != nil {
+		return nil, err
+	}
+
+	daemonSetList := <-daemonSetChan
+	err = <-errChan
+	if err != nil {
+		return nil, err
+	}
+
 	workloads := &Workloads{
 		ReplicaSetList:            *rsList,
 		ReplicationControllerList: *rcList,
 		DeploymentList:            *deploymentList,
 		PodList:                   *podList,
+		JobList:                   *jobList,
+		DaemonSetList:             *daemonSetList,
 	}
 
 	return workloads, nil
@@ -64,10 +64,39 @@ backendApi.Label;
  *   memoryRequirement: ?string,
  *   cpuRequirement: ?number,
  *   runAsPrivileged: boolean,
+ *   kind: ?string,
+ *   rollingUpdateStrategy: ?backendApi.RollingUpdateStrategy,
  * }}
  */
 backendApi.AppDeploymentSpec;
 
+/**
+ * @typedef {{
+ *   maxSurge: (number|string),
+ *   maxUnavailable: (number|string)
+ * }}
+ */
+backendApi.RollingUpdateStrategy;
+
+/**
+ * @typedef {{
+ *   kind: string,
+ *   name: string,
+ *   namespace: string
+ * }}
+ */
+backendApi.DeployedObject;
+
+/**
+ * @typedef {{
+ *   created: !Array<!backendApi.DeployedObject>,
+ *   rolledBack: !Array<!backendApi.DeployedObject>,
+ *   rollbackFailed: !Array<!backendApi.DeployedObject>,
+ *   error: string
+ * }}
+ */
+backendApi.AppDeploymentReport;
+
 /**
  * @typedef {{
  *   name: string,
@@ -87,6 +116,9 @@ backendApi.Events;
 /**
  * @typedef {{
  *   message: string,
+ *   objectKind: string,
+ *   objectName: string,
+ *   objectNamespace: string,
  *   sourceComponent: string,
  *   sourceHost: string,
  *   object: string,
@@ -110,6 +142,8 @@ backendApi.ReplicationControllerList;
  * @typedef {{
  *   replicationControllers: !backendApi.ReplicationControllerList,
  *   replicaSets: !backendApi.ReplicaSetList,
+ *   jobList: !backendApi.JobList,
+ *   daemonSetList: !backendApi.DaemonSetList,
  *   pods: !backendApi.PodList
  * }}
  */
@@ -182,6 +216,184 @@ backendApi.ReplicaSet;
  */
 backendApi.ReplicaSetList;
 
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   pods: !backendApi.PodInfo,
+ *   containerImages: !Array<string>,
+ *   completions: ?number,
+ *   parallelism: ?number,
+ *   active: number,
+ *   succeeded: number,
+ *   failed: number
+ * }}
+ */
+backendApi.Job;
+
+/**
+ * @typedef {{
+ *   jobs: !Array<!backendApi.Job>
+ * }}
+ */
+backendApi.JobList;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   pods: !backendApi.PodInfo,
+ *   containerImages: !Array<string>,
+ *   desiredNumberScheduled: number,
+ *   currentNumberScheduled: number,
+ *   numberMisscheduled: number
+ * }}
+ */
+backendApi.DaemonSet;
+
+/**
+ * @typedef {{
+ *   daemonSets: !Array<!backendApi.DaemonSet>
+ * }}
+ */
+backendApi.DaemonSetList;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   hosts: !Array<string>,
+ *   addresses: !Array<string>
+ * }}
+ */
+backendApi.Ingress;
+
+/**
+ * @typedef {{
+ *   ingresses: !Array<!backendApi.Ingress>
+ * }}
+ */
+backendApi.IngressList;
+
+/**
+ * @typedef {{
+ *   serviceName: string,
+ *   servicePort: (number|string),
+ *   status: string,
+ *   endpoints: !Array<!backendApi.ServiceEndpoint>
+ * }}
+ */
+backendApi.IngressBackend;
+
+/**
+ * @typedef {{
+ *   host: string,
+ *   path: string,
+ *   backend: ?backendApi.IngressBackend
+ * }}
+ */
+backendApi.IngressRule;
+
+/**
+ * @typedef {{
+ *   hosts: !Array<string>,
+ *   secretName: string
+ * }}
+ */
+backendApi.IngressTLS;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   addresses: !Array<string>,
+ *   tls: ?Array<!backendApi.IngressTLS>,
+ *   defaultBackend: ?backendApi.IngressBackend,
+ *   rules: !Array<!backendApi.IngressRule>
+ * }}
+ */
+backendApi.IngressDetail;
+
+/**
+ * @typedef {{
+ *   namespace: string,
+ *   name: string
+ * }}
+ */
+backendApi.ClaimReference;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   capacity: !Object<string, string>,
+ *   accessModes: !Array<string>,
+ *   reclaimPolicy: string,
+ *   status: string,
+ *   claim: ?backendApi.ClaimReference,
+ *   storageSource: string
+ * }}
+ */
+backendApi.PersistentVolume;
+
+/**
+ * @typedef {{
+ *   persistentVolumes: !Array<!backendApi.PersistentVolume>
+ * }}
+ */
+backendApi.PersistentVolumeList;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   capacity: !Object<string, string>,
+ *   accessModes: !Array<string>,
+ *   reclaimPolicy: string,
+ *   status: string,
+ *   message: string,
+ *   reason: string,
+ *   claim: ?backendApi.ClaimReference,
+ *   storageSource: string,
+ *   persistentVolumeSource: !Object
+ * }}
+ */
+backendApi.PersistentVolumeDetail;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   status: string,
+ *   volume: string,
+ *   capacity: ?Object<string, string>,
+ *   accessModes: ?Array<string>
+ * }}
+ */
+backendApi.PersistentVolumeClaim;
+
+/**
+ * @typedef {{
+ *   persistentVolumeClaims: !Array<!backendApi.PersistentVolumeClaim>
+ * }}
+ */
+backendApi.PersistentVolumeClaimList;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   status: string,
+ *   volume: string,
+ *   request: ?Object<string, string>,
+ *   requestedAccessModes: ?Array<string>,
+ *   capacity: ?Object<string, string>,
+ *   accessModes: ?Array<string>,
+ *   pods: !backendApi.PodList
+ * }}
+ */
+backendApi.PersistentVolumeClaimDetail;
+
 /**
  * @typedef {{
  *   pods: !Array<!backendApi.Pod>
@@ -255,11 +467,23 @@ backendApi.Pod;
  *  externalEndpoints: !Array<!backendApi.Endpoint>,
  *  selector: !Object<string, string>,
  *  type: string,
- *  clusterIP: string
+ *  clusterIP: string,
+ *  endpoints: !Array<!backendApi.ServiceEndpoint>,
+ *  pods: !backendApi.PodList
  * }}
  */
 backendApi.ServiceDetail;
 
+/**
+ * @typedef {{
+ *  host: string,
+ *  ports: !Array<{name: string, port: number, protocol: string}>,
+ *  ready: boolean,
+ *  targetRef: ?Object
+ * }}
+ */
+backendApi.ServiceEndpoint;
+
 /**
  * @typedef {{
  *  objectMeta: !backendApi.ObjectMeta,
@@ -289,11 +513,50 @@ backendApi.Endpoint;
 
 /**
  * @typedef {{
- *   name: string
+ *   name: string,
+ *   labels: !Object<string, string>,
+ *   annotations: !Object<string, string>
  * }}
  */
 backendApi.NamespaceSpec;
 
+/**
+ * @typedef {{
+ *   pods: number,
+ *   replicationControllers: number,
+ *   replicaSets: number,
+ *   deployments: number,
+ *   jobs: number,
+ *   daemonSets: number,
+ *   services: number,
+ *   ingresses: number,
+ *   persistentVolumeClaims: number,
+ *   secrets: number
+ * }}
+ */
+backendApi.ObjectCounts;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   resources: !Array<{name: string, used: string, hard: string}>
+ * }}
+ */
+backendApi.ResourceQuota;
+
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   phase: string,
+ *   resourceQuotas: !Array<!backendApi.ResourceQuota>,
+ *   limitRanges: !Array<{objectMeta: !backendApi.ObjectMeta, limits: !Array<!Object>}>,
+ *   objectCounts: !backendApi.ObjectCounts,
+ *   events: !backendApi.Events
+ * }}
+ */
+backendApi.NamespaceDetail;
+
 /**
  * @typedef {{
  *   namespaces: !Array<string>
@@ -404,5 +667,24 @@ backendApi.SecretSpec;
  */
 backendApi.SecretsList;
 
+/**
+ * @typedef {{
+ *   objectMeta: !backendApi.ObjectMeta,
+ *   typeMeta: !backendApi.TypeMeta,
+ *   type: string,
+ *   keys: !Array<{name: string, size: number}>,
+ *   pods: !Array<{name: string, usages: !Array<string>}>
+ * }}
+ */
+backendApi.SecretDetail;
+
+/**
+ * @typedef {{
+ *   key: string,
+ *   value: string
+ * }}
+ */
+backendApi.SecretValue;
+
 /** @typedef {{serverTime: number}} */
 const appConfig_DO_NOT_USE_DIRECTLY = {};
@@ -0,0 +1,98 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+/**
+ * Delay after the first change notification before the callback is called, so that changes that
+ * come in short succession, e.g., when a controller creates many pods, trigger a single refresh.
+ * @type {number}
+ */
+export const notificationDelayMs = 1000;
+
+/**
+ * Service that notifies about changes of resources watched by the backend. The backend pushes
+ * notifications as server-sent events.
+ *
+ * @final
+ */
+export class WatchService {
+  /**
+   * @param {!angular.$window} $window
+   * @param {!angular.$timeout} $timeout
+   * @ngInject
+   */
+  constructor($window, $timeout) {
+    /** @private {!angular.$window} */
+    this.window_ = $window;
+
+    /** @private {!angular.$timeout} */
+    this.timeout_ = $timeout;
+  }
+
+  /**
+   * Watches resources of the given type and calls the callback when any of them is added,
+   * modified or deleted. Watching stops when the scope is destroyed.
+   *
+   * @param {!angular.Scope} scope
+   * @param {string} resource Name of the resource as in the API paths, e.g., 'pods'.
+   * @param {string} namespace Namespace to watch. Empty string watches all namespaces.
+   * @param {!Object<string, string>} labelSelector Only resources with matching labels are
+   *     watched.
+   * @param {function()} callback
+   * @export
+   */
+  watch(scope, resource, namespace, labelSelector, callback) {
+    let eventSource =
+        new this.window_.EventSource(this.getWatchUrl_(resource, namespace, labelSelector));
+
+    /** @type {?angular.$q.Promise} */
+    let pendingCallback = null;
+    eventSource.onmessage = () => {
+      if (!pendingCallback) {
+        pendingCallback = this.timeout_(() => {
+          pendingCallback = null;
+          callback();
+        }, notificationDelayMs);
+      }
+    };
+    // Watch failures are not recoverable by reconnecting, e.g., when access is denied.
+    eventSource.addEventListener('watchError', () => eventSource.close());
+
+    scope.$on('$destroy', () => {
+      eventSource.close();
+      if (pendingCallback) {
+        this.timeout_.cancel(pendingCallback);
+      }
+    });
+  }
+
+  /**
+   * @param {string} resource
+   * @param {string} namespace
+   * @param {!Object<string, string>} labelSelector
+   * @return {string}
+   * @private
+   */
+  getWatchUrl_(resource, namespace, labelSelector) {
+    let url = `api/v1/watch/${resource}`;
+    if (namespace) {
+      url += `/${namespace}`;
+    }
+    let selector = Object.keys(labelSelector).map((key) => `${key}=${labelSelector[key]}`);
+    if (selector.length > 0) {
+      url += `?labelSelector=${encodeURIComponent(selector.join(','))}`;
+    }
+    return url;
+  }
+}
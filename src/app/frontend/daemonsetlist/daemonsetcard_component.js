@@ -0,0 +1,84 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {StateParams} from 'daemonsetdetail/daemonsetdetail_state';
+import {stateName} from 'daemonsetdetail/daemonsetdetail_state';
+
+/**
+ * Controller for the daemon set card.
+ *
+ * @final
+ */
+export default class DaemonSetCardController {
+  /**
+   * @param {!ui.router.$state} $state
+   * @ngInject
+   */
+  constructor($state) {
+    /**
+     * Initialized from the scope.
+     * @export {!backendApi.DaemonSet}
+     */
+    this.daemonSet;
+
+    /** @private {!ui.router.$state} */
+    this.state_ = $state;
+  }
+
+  /**
+   * @return {string}
+   * @export
+   */
+  getDaemonSetDetailHref() {
+    return this.state_.href(
+        stateName,
+        new StateParams(this.daemonSet.objectMeta.namespace, this.daemonSet.objectMeta.name));
+  }
+
+  /**
+   * Returns true if any of daemon set pods has warning, false otherwise
+   * @return {boolean}
+   * @export
+   */
+  hasWarnings() { return this.daemonSet.pods.warnings.length > 0; }
+
+  /**
+   * Returns true if daemon set pods have no warnings and the daemon pod is not yet scheduled to
+   * all desired nodes or there is at least one pod in pending state, false otherwise
+   * @return {boolean}
+   * @export
+   */
+  isPending() {
+    return !this.hasWarnings() &&
+        (this.daemonSet.currentNumberScheduled < this.daemonSet.desiredNumberScheduled ||
+         this.daemonSet.pods.pending > 0);
+  }
+
+  /**
+   * @return {boolean}
+   * @export
+   */
+  isSuccess() { return !this.isPending() && !this.hasWarnings(); }
+}
+
+/**
+ * @return {!angular.Component}
+ */
+export const daemonSetCardComponent = {
+  bindings: {
+    'daemonSet': '=',
+  },
+  controller: DaemonSetCardController,
+  templateUrl: 'daemonsetlist/daemonsetcard.html',
+};
@@ -66,8 +66,35 @@ export default class NamespaceDialogController {
 
     /** @export {!angular.FormController} */
     this.namespaceForm;
+
+    /**
+     * Labels of the new namespace. Entries with empty key are ignored.
+     * @export {!Array<{key: string, value: string}>}
+     */
+    this.labels = [{key: '', value: ''}];
+
+    /**
+     * Annotations of the new namespace. Entries with empty key are ignored.
+     * @export {!Array<{key: string, value: string}>}
+     */
+    this.annotations = [{key: '', value: ''}];
   }
 
+  /**
+   * Adds an empty entry to the given list of labels or annotations.
+   * @param {!Array<{key: string, value: string}>} entries
+   * @export
+   */
+  addEntry(entries) { entries.push({key: '', value: ''}); }
+
+  /**
+   * Removes the entry with the given index from the given list of labels or annotations.
+   * @param {!Array<{key: string, value: string}>} entries
+   * @param {number} index
+   * @export
+   */
+  removeEntry(entries, index) { entries.splice(index, 1); }
+
   /**
    * Returns true if new namespace name hasn't been filled by the user, i.e, is empty.
    * @return {boolean}
@@ -89,7 +116,11 @@ export default class NamespaceDialogController {
     if (!this.namespaceForm.$valid) return;
 
     /** @type {!backendApi.NamespaceSpec} */
-    let namespaceSpec = {name: this.namespace};
+    let namespaceSpec = {
+      name: this.namespace,
+      labels: this.toMap_(this.labels),
+      annotations: this.toMap_(this.annotations),
+    };
 
     /** @type {!angular.Resource<!backendApi.NamespaceSpec>} */
     let resource = this.resource_('api/v1/namespaces');
@@ -102,8 +133,23 @@ export default class NamespaceDialogController {
         },
         (err) => {
           this.mdDialog_.hide();
-          this.errorDialog_.open('Error creating namespace', err.data);
+          this.errorDialog_.open('Error creating namespace', err.data.message);
           this.log_.info('Error creating namespace:', err);
         });
   }
+
+  /**
+   * Converts list of key-value entries to a map, omitting entries with empty key.
+   * @param {!Array<{key: string, value: string}>} entries
+   * @return {!Object<string, string>}
+   * @private
+   */
+  toMap_(entries) {
+    /** @type {!Object<string, string>} */
+    let result = {};
+    entries.filter((entry) => entry.key.length !== 0).forEach((entry) => {
+      result[entry.key] = entry.value;
+    });
+    return result;
+  }
 }
@@ -112,7 +112,7 @@ export default class CreateSecretController {
         },
         (err) => {
           this.mdDialog_.hide();
-          this.errorDialog_.open('Error creating secret', err.data);
+          this.errorDialog_.open('Error creating secret', err.data.message);
           this.log_.info('Error creating secret:', err);
         });
   }
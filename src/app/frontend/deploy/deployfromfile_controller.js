@@ -93,7 +93,7 @@ export default class DeployFromFileController {
         (err) => {
           defer.reject(err);  // Progress ends
           this.log_.error('Error deploying application:', err);
-          this.errorDialog_.open('Deploying file has failed', err.data);
+          this.errorDialog_.open('Deploying file has failed', err.data.message);
         });
     return defer.promise;
   }
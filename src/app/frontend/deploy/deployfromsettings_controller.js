@@ -15,9 +15,9 @@
 import showNamespaceDialog from './createnamespace_dialog';
 import showCreateSecretDialog from './createsecret_dialog';
 import DeployLabel from './deploylabel';
-import {stateName as replicationcontrollerliststate} from 'replicationcontrollerlistdeprecated/replicationcontrollerlist_state';
 import {uniqueNameValidationKey} from './uniquename_directive';
 import DockerImageReference from '../common/docker/dockerimagereference';
+import {stateName as workloadsState} from 'workloads/workloads_state';
 
 // Label keys for predefined labels
 const APP_LABEL_KEY = 'app';
@@ -143,6 +143,45 @@ export default class DeployFromSettingsController {
      */
     this.runAsPrivileged = false;
 
+    /**
+     * Whether to run the pods to completion as a Job instead of keeping them running.
+     * @export {boolean}
+     */
+    this.runToCompletion = false;
+
+    /**
+     * Kind of the resource to create for the application. When empty, the server creates a
+     * Deployment if it supports them and a Replication Controller otherwise.
+     * @export {string}
+     */
+    this.kind = '';
+
+    /**
+     * Kinds of resources the application can be deployed as.
+     * @export {!Array<string>}
+     */
+    this.kinds = ['ReplicationController', 'Deployment', 'DaemonSet'];
+
+    /**
+     * Maximum number or percentage of pods above the desired number during a rolling update.
+     * Used only when the application is deployed as a Deployment.
+     * @export {string}
+     */
+    this.maxSurge = '1';
+
+    /**
+     * Maximum number or percentage of unavailable pods during a rolling update. Used only when the
+     * application is deployed as a Deployment.
+     * @export {string}
+     */
+    this.maxUnavailable = '1';
+
+    /**
+     * Checks that a rolling update parameter is an absolute number or a percentage.
+     * @export {!RegExp}
+     */
+    this.rollingUpdateParameterPattern = new RegExp('^[0-9]+%?$');
+
     /**
      * Currently chosen namespace.
      * @export {string}
@@ -201,6 +240,8 @@ export default class DeployFromSettingsController {
                                                                     null,
       labels: this.toBackendApiLabels_(this.labels),
       runAsPrivileged: this.runAsPrivileged,
+      kind: this.getKind_(),
+      rollingUpdateStrategy: this.getRollingUpdateStrategy_(),
     };
 
     let defer = this.q_.defer();
@@ -209,10 +250,10 @@ export default class DeployFromSettingsController {
     let resource = this.resource_('api/v1/appdeployments');
     resource.save(
         appDeploymentSpec,
-        (savedConfig) => {
-          defer.resolve(savedConfig);  // Progress ends
-          this.log_.info('Successfully deployed application: ', savedConfig);
-          this.state_.go(replicationcontrollerliststate);
+        (report) => {
+          defer.resolve(report);  // Progress ends
+          this.log_.info('Successfully deployed application: ', report);
+          this.state_.go(workloadsState);
         },
         (err) => {
           defer.reject(err);  // Progress ends
@@ -221,6 +262,14 @@ export default class DeployFromSettingsController {
     return defer.promise;
   }
 
+  /**
+   * Returns true when rolling update parameters apply to the chosen kind of the application.
+   *
+   * @return {boolean}
+   * @export
+   */
+  isRollingUpdateEnabled() { return !this.runToCompletion && this.kind === 'Deployment'; }
+
   /**
    * Displays new namespace creation dialog.
    *
@@ -306,6 +355,44 @@ export default class DeployFromSettingsController {
         (name.$invalid && (name.$touched || this.form.$submitted));
   }
 
+  /**
+   * Returns kind of the resource the application is deployed as, or null to let the server choose.
+   *
+   * @return {?string}
+   * @private
+   */
+  getKind_() {
+    if (this.runToCompletion) {
+      return 'Job';
+    }
+    return this.kind ? this.kind : null;
+  }
+
+  /**
+   * Returns rolling update parameters of the application, or null when they do not apply.
+   *
+   * @return {?backendApi.RollingUpdateStrategy}
+   * @private
+   */
+  getRollingUpdateStrategy_() {
+    if (!this.isRollingUpdateEnabled()) {
+      return null;
+    }
+    return {
+      maxSurge: this.toIntOrString_(this.maxSurge),
+      maxUnavailable: this.toIntOrString_(this.maxUnavailable),
+    };
+  }
+
+  /**
+   * Converts rolling update parameter to a number, unless it is a percentage.
+   *
+   * @param {string} value
+   * @return {number|string}
+   * @private
+   */
+  toIntOrString_(value) { return value.indexOf('%') !== -1 ? value : parseInt(value, 10); }
+
   /**
    * Converts array of DeployLabel to array of backend api label
    * @param {!Array<!DeployLabel>} labels
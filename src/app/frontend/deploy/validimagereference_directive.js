@@ -70,7 +70,7 @@ function validate(reference, scope, resource, q) {
         }
       },
       (err) => {
-        scope[invalidImageErrorMessage] = err.data;
+        scope[invalidImageErrorMessage] = err.data.message;
         deferred.reject();
       });
 
@@ -21,11 +21,16 @@ import deployModule from './deploy/deploy_module';
 import deprecatedReplicationControllerListModule from './replicationcontrollerlistdeprecated/replicationcontrollerlist_module';
 import errorModule from './error/error_module';
 import indexConfig from './index_config';
+import ingressListModule from './ingresslist/ingresslist_module';
 import logsModule from './logs/logs_module';
+import namespaceDetailModule from './namespacedetail/namespacedetail_module';
+import persistentVolumeClaimListModule from './persistentvolumeclaimlist/persistentvolumeclaimlist_module';
+import persistentVolumeListModule from './persistentvolumelist/persistentvolumelist_module';
 import replicaSetListModule from './replicasetlist/replicasetlist_module';
 import replicationControllerDetailModule from './replicationcontrollerdetail/replicationcontrollerdetail_module';
 import replicationControllerListModule from './replicationcontrollerlist/replicationcontrollerlist_module';
 import routeConfig from './index_route';
+import secretDetailModule from './secretdetail/secretdetail_module';
 import serviceDetailModule from './servicedetail/servicedetail_module';
 import serviceListModule from './servicelist/servicelist_module';
 import workloadsModule from './workloads/workloads_module';
@@ -52,6 +57,11 @@ export default angular
           workloadsModule.name,
           serviceDetailModule.name,
           serviceListModule.name,
+          ingressListModule.name,
+          persistentVolumeListModule.name,
+          persistentVolumeClaimListModule.name,
+          secretDetailModule.name,
+          namespaceDetailModule.name,
         ])
     .config(indexConfig)
     .config(routeConfig);
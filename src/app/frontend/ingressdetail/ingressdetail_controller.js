@@ -0,0 +1,63 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {StateParams, stateName} from 'servicedetail/servicedetail_state';
+
+/**
+ * Messages shown for backends that do not route traffic to any pod, by backend status.
+ * @type {!Object<string, string>}
+ */
+const backendProblems = {
+  'ServiceNotFound': 'Service does not exist',
+  'PortNotFound': 'Service does not expose this port',
+  'NoReadyEndpoints': 'Service has no ready endpoints',
+};
+
+/**
+ * @final
+ */
+export class IngressDetailController {
+  /**
+   * @param {!backendApi.IngressDetail} ingressDetail
+   * @param {!ui.router.$state} $state
+   * @ngInject
+   */
+  constructor(ingressDetail, $state) {
+    /** @export {!backendApi.IngressDetail} */
+    this.ingressDetail = ingressDetail;
+
+    /** @private {!ui.router.$state} */
+    this.state_ = $state;
+  }
+
+  /**
+   * Returns description of the problem of the backend or empty string when it routes traffic to
+   * ready endpoints.
+   *
+   * @param {!backendApi.IngressBackend} backend
+   * @return {string}
+   * @export
+   */
+  getBackendProblem(backend) { return backendProblems[backend.status] || ''; }
+
+  /**
+   * @param {!backendApi.IngressBackend} backend
+   * @return {string}
+   * @export
+   */
+  getServiceDetailHref(backend) {
+    return this.state_.href(
+        stateName, new StateParams(this.ingressDetail.objectMeta.namespace, backend.serviceName));
+  }
+}
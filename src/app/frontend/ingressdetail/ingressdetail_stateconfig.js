@@ -0,0 +1,66 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {actionbarViewName} from 'chrome/chrome_state';
+import {breadcrumbsConfig} from 'common/components/breadcrumbs/breadcrumbs_component';
+import {stateName} from './ingressdetail_state';
+import {stateName as ingressList, stateUrl} from './../ingresslist/ingresslist_state';
+import {IngressDetailController} from './ingressdetail_controller';
+
+/**
+ * Configures states for the ingress details view.
+ *
+ * @param {!ui.router.$stateProvider} $stateProvider
+ * @ngInject
+ */
+export default function stateConfig($stateProvider) {
+  $stateProvider.state(stateName, {
+    url: `${stateUrl}/:namespace/:ingress`,
+    resolve: {
+      'ingressDetailResource': getIngressDetailResource,
+      'ingressDetail': resolveIngressDetail,
+    },
+    data: {
+      [breadcrumbsConfig]: {
+        'label': '{{$stateParams.ingress}}',
+        'parent': ingressList,
+      },
+    },
+    views: {
+      '': {
+        controller: IngressDetailController,
+        controllerAs: 'ctrl',
+        templateUrl: 'ingressdetail/ingressdetail.html',
+      },
+      [actionbarViewName]: {},
+    },
+  });
+}
+
+/**
+ * @param {!./ingressdetail_state.StateParams} $stateParams
+ * @param {!angular.$resource} $resource
+ * @return {!angular.Resource<!backendApi.IngressDetail>}
+ */
+export function getIngressDetailResource($stateParams, $resource) {
+  return $resource(`api/v1/ingresses/${$stateParams.namespace}/${$stateParams.ingress}`);
+}
+
+/**
+ * @param {!angular.Resource<!backendApi.IngressDetail>} ingressDetailResource
+ * @return {!angular.$q.Promise}
+ */
+export function resolveIngressDetail(ingressDetailResource) {
+  return ingressDetailResource.get().$promise;
+}
@@ -0,0 +1,50 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {StateParams, stateName} from 'ingressdetail/ingressdetail_state';
+
+/**
+ * @final
+ */
+export class IngressCardListController {
+  /**
+   * @param {!ui.router.$state} $state
+   * @ngInject
+   */
+  constructor($state) { this.state_ = $state; }
+
+  /**
+   * @param {!backendApi.Ingress} ingress
+   * @return {string}
+   * @export
+   */
+  getIngressDetailHref(ingress) {
+    return this.state_.href(
+        stateName, new StateParams(ingress.objectMeta.namespace, ingress.objectMeta.name));
+  }
+}
+
+/**
+ * Definition object for the component that displays ingress card list.
+ *
+ * @type {!angular.Component}
+ */
+export const ingressCardListComponent = {
+  templateUrl: 'ingresslist/ingresscardlist.html',
+  controller: IngressCardListController,
+  bindings: {
+    /** {!Array<!backendApi.Ingress>} */
+    'ingresses': '<',
+  },
+};
@@ -0,0 +1,63 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {IngressListController} from './ingresslist_controller';
+import {actionbarViewName} from 'chrome/chrome_state';
+import {breadcrumbsConfig} from 'common/components/breadcrumbs/breadcrumbs_component';
+import {stateName, stateUrl} from './ingresslist_state';
+
+/**
+ * Configures states for the ingress list view.
+ *
+ * @param {!ui.router.$stateProvider} $stateProvider
+ * @ngInject
+ */
+export default function stateConfig($stateProvider) {
+  $stateProvider.state(stateName, {
+    url: stateUrl,
+    resolve: {
+      'ingressListResource': getIngressListResource,
+      'ingressList': resolveIngressList,
+    },
+    data: {
+      [breadcrumbsConfig]: {
+        'label': 'Ingresses',
+      },
+    },
+    views: {
+      '': {
+        controller: IngressListController,
+        controllerAs: 'ctrl',
+        templateUrl: 'ingresslist/ingresslist.html',
+      },
+      [actionbarViewName]: {},
+    },
+  });
+}
+
+/**
+ * @param {!angular.$resource} $resource
+ * @return {!angular.Resource<!backendApi.IngressList>}
+ */
+export function getIngressListResource($resource) {
+  return $resource('api/v1/ingresses');
+}
+
+/**
+ * @param {!angular.Resource<!backendApi.IngressList>} ingressListResource
+ * @return {!angular.$q.Promise}
+ */
+export function resolveIngressList(ingressListResource) {
+  return ingressListResource.get().$promise;
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {StateParams} from 'jobdetail/jobdetail_state';
+import {stateName} from 'jobdetail/jobdetail_state';
+
+/**
+ * Controller for the job card.
+ *
+ * @final
+ */
+export default class JobCardController {
+  /**
+   * @param {!ui.router.$state} $state
+   * @ngInject
+   */
+  constructor($state) {
+    /**
+     * Initialized from the scope.
+     * @export {!backendApi.Job}
+     */
+    this.job;
+
+    /** @private {!ui.router.$state} */
+    this.state_ = $state;
+  }
+
+  /**
+   * @return {string}
+   * @export
+   */
+  getJobDetailHref() {
+    return this.state_.href(
+        stateName, new StateParams(this.job.objectMeta.namespace, this.job.objectMeta.name));
+  }
+
+  /**
+   * Returns number of successful completions the job needs. Defaults to 1 when not set.
+   * @return {number}
+   * @export
+   */
+  getCompletions() { return this.job.completions || 1; }
+
+  /**
+   * Returns true if any of job pods has warning or any of its pods failed, false otherwise.
+   * @return {boolean}
+   * @export
+   */
+  hasWarnings() { return this.job.pods.warnings.length > 0 || this.job.failed > 0; }
+
+  /**
+   * Returns true if the job has no warnings and still has active pods, false otherwise.
+   * @return {boolean}
+   * @export
+   */
+  isRunning() { return !this.hasWarnings() && this.job.active > 0; }
+
+  /**
+   * @return {boolean}
+   * @export
+   */
+  isSuccess() { return !this.isRunning() && !this.hasWarnings(); }
+}
+
+/**
+ * @return {!angular.Component}
+ */
+export const jobCardComponent = {
+  bindings: {
+    'job': '=',
+  },
+  controller: JobCardController,
+  templateUrl: 'joblist/jobcard.html',
+};
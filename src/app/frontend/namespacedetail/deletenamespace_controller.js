@@ -0,0 +1,65 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import {StateParams} from './namespacedetail_state';
+import {getNamespaceDetailResource} from './namespacedetail_stateconfig';
+
+/**
+ * Controller for the delete namespace dialog.
+ *
+ * @final
+ */
+export default class DeleteNamespaceDialogController {
+  /**
+   * @param {!md.$dialog} $mdDialog
+   * @param {!angular.$resource} $resource
+   * @param {!backendApi.NamespaceDetail} namespaceDetail
+   * @ngInject
+   */
+  constructor($mdDialog, $resource, namespaceDetail) {
+    /** @export {string} */
+    this.namespace = namespaceDetail.objectMeta.name;
+
+    /**
+     * Objects that will be destroyed together with the namespace.
+     * @export {!backendApi.ObjectCounts}
+     */
+    this.objectCounts = namespaceDetail.objectCounts;
+
+    /** @private {!md.$dialog} */
+    this.mdDialog_ = $mdDialog;
+
+    /** @private {!angular.$resource} */
+    this.resource_ = $resource;
+  }
+
+  /**
+   * Deletes the namespace and closes the dialog.
+   *
+   * @export
+   */
+  remove() {
+    let resource = getNamespaceDetailResource(new StateParams(this.namespace), this.resource_);
+
+    resource.remove(() => { this.mdDialog_.hide(); }, () => { this.mdDialog_.cancel(); });
+  }
+
+  /**
+   * Cancels and closes the dialog.
+   *
+   * @export
+   */
+  cancel() { this.mdDialog_.cancel(); }
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import {stateName as workloadsState} from 'workloads/workloads_state';
+import showDeleteNamespaceDialog from './deletenamespace_dialog';
+
+/**
+ * Controller for the namespace details view.
+ *
+ * @final
+ */
+export class NamespaceDetailController {
+  /**
+   * @param {!backendApi.NamespaceDetail} namespaceDetail
+   * @param {!md.$dialog} $mdDialog
+   * @param {!ui.router.$state} $state
+   * @param {!angular.$log} $log
+   * @ngInject
+   */
+  constructor(namespaceDetail, $mdDialog, $state, $log) {
+    /** @export {!backendApi.NamespaceDetail} */
+    this.namespaceDetail = namespaceDetail;
+
+    /** @private {!md.$dialog} */
+    this.mdDialog_ = $mdDialog;
+
+    /** @private {!ui.router.$state} */
+    this.state_ = $state;
+
+    /** @private {!angular.$log} */
+    this.log_ = $log;
+  }
+
+  /**
+   * Asks for confirmation, listing objects that will be destroyed, and deletes the namespace.
+   *
+   * @export
+   */
+  handleDeleteNamespaceDialog() {
+    showDeleteNamespaceDialog(this.mdDialog_, this.namespaceDetail)
+        .then(() => {
+          this.log_.info('Successfully deleted namespace');
+          this.state_.go(workloadsState);
+        });
+  }
+}
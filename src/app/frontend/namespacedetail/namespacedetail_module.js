@@ -0,0 +1,33 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import componentsModule from './../common/components/components_module';
+import stateConfig from './namespacedetail_stateconfig';
+
+/**
+ * Angular module for the Namespace details view.
+ *
+ * The view shows quotas, limit ranges, objects and events of a Namespace and allows to delete it.
+ */
+export default angular
+    .module(
+        'kubernetesDashboard.namespaceDetail',
+        [
+          'ngMaterial',
+          'ngResource',
+          'ui.router',
+          componentsModule.name,
+        ])
+    .config(stateConfig);
@@ -0,0 +1,65 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import {actionbarViewName} from 'chrome/chrome_state';
+import {breadcrumbsConfig} from 'common/components/breadcrumbs/breadcrumbs_component';
+import {stateName, stateUrl} from './namespacedetail_state';
+import {NamespaceDetailController} from './namespacedetail_controller';
+
+/**
+ * Configures states for the namespace details view.
+ *
+ * @param {!ui.router.$stateProvider} $stateProvider
+ * @ngInject
+ */
+export default function stateConfig($stateProvider) {
+  $stateProvider.state(stateName, {
+    url: `${stateUrl}/:namespace`,
+    resolve: {
+      'namespaceDetailResource': getNamespaceDetailResource,
+      'namespaceDetail': resolveNamespaceDetail,
+    },
+    data: {
+      [breadcrumbsConfig]: {
+        'label': '{{$stateParams.namespace}}',
+      },
+    },
+    views: {
+      '': {
+        controller: NamespaceDetailController,
+        controllerAs: 'ctrl',
+        templateUrl: 'namespacedetail/namespacedetail.html',
+      },
+      [actionbarViewName]: {},
+    },
+  });
+}
+
+/**
+ * @param {!./namespacedetail_state.StateParams} $stateParams
+ * @param {!angular.$resource} $resource
+ * @return {!angular.Resource<!backendApi.NamespaceDetail>}
+ */
+export function getNamespaceDetailResource($stateParams, $resource) {
+  return $resource(`api/v1/namespaces/${$stateParams.namespace}`);
+}
+
+/**
+ * @param {!angular.Resource<!backendApi.NamespaceDetail>} namespaceDetailResource
+ * @return {!angular.$q.Promise}
+ */
+export function resolveNamespaceDetail(namespaceDetailResource) {
+  return namespaceDetailResource.get().$promise;
+}
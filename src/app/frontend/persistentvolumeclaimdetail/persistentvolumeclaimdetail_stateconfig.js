@@ -0,0 +1,69 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {actionbarViewName} from 'chrome/chrome_state';
+import {breadcrumbsConfig} from 'common/components/breadcrumbs/breadcrumbs_component';
+import {stateName} from './persistentvolumeclaimdetail_state';
+import {stateName as persistentVolumeClaimList, stateUrl} from './../persistentvolumeclaimlist/persistentvolumeclaimlist_state';
+import {PersistentVolumeClaimDetailController} from './persistentvolumeclaimdetail_controller';
+
+/**
+ * Configures states for the persistent volume claim details view.
+ *
+ * @param {!ui.router.$stateProvider} $stateProvider
+ * @ngInject
+ */
+export default function stateConfig($stateProvider) {
+  $stateProvider.state(stateName, {
+    url: `${stateUrl}/:namespace/:persistentVolumeClaim`,
+    resolve: {
+      'persistentVolumeClaimDetailResource': getPersistentVolumeClaimDetailResource,
+      'persistentVolumeClaimDetail': resolvePersistentVolumeClaimDetail,
+    },
+    data: {
+      [breadcrumbsConfig]: {
+        'label': '{{$stateParams.persistentVolumeClaim}}',
+        'parent': persistentVolumeClaimList,
+      },
+    },
+    views: {
+      '': {
+        controller: PersistentVolumeClaimDetailController,
+        controllerAs: 'ctrl',
+        templateUrl: 'persistentvolumeclaimdetail/persistentvolumeclaimdetail.html',
+      },
+      [actionbarViewName]: {},
+    },
+  });
+}
+
+/**
+ * @param {!./persistentvolumeclaimdetail_state.StateParams} $stateParams
+ * @param {!angular.$resource} $resource
+ * @return {!angular.Resource<!backendApi.PersistentVolumeClaimDetail>}
+ */
+export function getPersistentVolumeClaimDetailResource($stateParams, $resource) {
+  return $resource(
+      `api/v1/persistentvolumeclaims/${$stateParams.namespace}/` +
+      `${$stateParams.persistentVolumeClaim}`);
+}
+
+/**
+ * @param {!angular.Resource<!backendApi.PersistentVolumeClaimDetail>}
+ *     persistentVolumeClaimDetailResource
+ * @return {!angular.$q.Promise}
+ */
+export function resolvePersistentVolumeClaimDetail(persistentVolumeClaimDetailResource) {
+  return persistentVolumeClaimDetailResource.get().$promise;
+}
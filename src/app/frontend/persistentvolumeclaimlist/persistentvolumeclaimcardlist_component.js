@@ -0,0 +1,60 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {StateParams, stateName} from 'persistentvolumeclaimdetail/persistentvolumeclaimdetail_state';
+import {StateParams as VolumeStateParams, stateName as volumeStateName} from 'persistentvolumedetail/persistentvolumedetail_state';
+
+/**
+ * @final
+ */
+export class PersistentVolumeClaimCardListController {
+  /**
+   * @param {!ui.router.$state} $state
+   * @ngInject
+   */
+  constructor($state) { this.state_ = $state; }
+
+  /**
+   * @param {!backendApi.PersistentVolumeClaim} claim
+   * @return {string}
+   * @export
+   */
+  getClaimDetailHref(claim) {
+    return this.state_.href(
+        stateName, new StateParams(claim.objectMeta.namespace, claim.objectMeta.name));
+  }
+
+  /**
+   * @param {!backendApi.PersistentVolumeClaim} claim
+   * @return {string}
+   * @export
+   */
+  getVolumeDetailHref(claim) {
+    return this.state_.href(volumeStateName, new VolumeStateParams(claim.volume));
+  }
+}
+
+/**
+ * Definition object for the component that displays persistent volume claim card list.
+ *
+ * @type {!angular.Component}
+ */
+export const persistentVolumeClaimCardListComponent = {
+  templateUrl: 'persistentvolumeclaimlist/persistentvolumeclaimcardlist.html',
+  controller: PersistentVolumeClaimCardListController,
+  bindings: {
+    /** {!Array<!backendApi.PersistentVolumeClaim>} */
+    'persistentVolumeClaims': '<',
+  },
+};
@@ -0,0 +1,42 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {StateParams, stateName} from 'persistentvolumeclaimdetail/persistentvolumeclaimdetail_state';
+
+/**
+ * @final
+ */
+export class PersistentVolumeDetailController {
+  /**
+   * @param {!backendApi.PersistentVolumeDetail} persistentVolumeDetail
+   * @param {!ui.router.$state} $state
+   * @ngInject
+   */
+  constructor(persistentVolumeDetail, $state) {
+    /** @export {!backendApi.PersistentVolumeDetail} */
+    this.persistentVolumeDetail = persistentVolumeDetail;
+
+    /** @private {!ui.router.$state} */
+    this.state_ = $state;
+  }
+
+  /**
+   * @return {string}
+   * @export
+   */
+  getClaimDetailHref() {
+    let claim = this.persistentVolumeDetail.claim;
+    return this.state_.href(stateName, new StateParams(claim.namespace, claim.name));
+  }
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {actionbarViewName} from 'chrome/chrome_state';
+import {breadcrumbsConfig} from 'common/components/breadcrumbs/breadcrumbs_component';
+import {stateName} from './persistentvolumedetail_state';
+import {stateName as persistentVolumeList, stateUrl} from './../persistentvolumelist/persistentvolumelist_state';
+import {PersistentVolumeDetailController} from './persistentvolumedetail_controller';
+
+/**
+ * Configures states for the persistent volume details view.
+ *
+ * @param {!ui.router.$stateProvider} $stateProvider
+ * @ngInject
+ */
+export default function stateConfig($stateProvider) {
+  $stateProvider.state(stateName, {
+    url: `${stateUrl}/:persistentVolume`,
+    resolve: {
+      'persistentVolumeDetailResource': getPersistentVolumeDetailResource,
+      'persistentVolumeDetail': resolvePersistentVolumeDetail,
+    },
+    data: {
+      [breadcrumbsConfig]: {
+        'label': '{{$stateParams.persistentVolume}}',
+        'parent': persistentVolumeList,
+      },
+    },
+    views: {
+      '': {
+        controller: PersistentVolumeDetailController,
+        controllerAs: 'ctrl',
+        templateUrl: 'persistentvolumedetail/persistentvolumedetail.html',
+      },
+      [actionbarViewName]: {},
+    },
+  });
+}
+
+/**
+ * @param {!./persistentvolumedetail_state.StateParams} $stateParams
+ * @param {!angular.$resource} $resource
+ * @return {!angular.Resource<!backendApi.PersistentVolumeDetail>}
+ */
+export function getPersistentVolumeDetailResource($stateParams, $resource) {
+  return $resource(`api/v1/persistentvolumes/${$stateParams.persistentVolume}`);
+}
+
+/**
+ * @param {!angular.Resource<!backendApi.PersistentVolumeDetail>} persistentVolumeDetailResource
+ * @return {!angular.$q.Promise}
+ */
+export function resolvePersistentVolumeDetail(persistentVolumeDetailResource) {
+  return persistentVolumeDetailResource.get().$promise;
+}
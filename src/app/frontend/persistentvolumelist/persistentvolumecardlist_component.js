@@ -0,0 +1,59 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {StateParams as ClaimStateParams, stateName as claimStateName} from 'persistentvolumeclaimdetail/persistentvolumeclaimdetail_state';
+import {StateParams, stateName} from 'persistentvolumedetail/persistentvolumedetail_state';
+
+/**
+ * @final
+ */
+export class PersistentVolumeCardListController {
+  /**
+   * @param {!ui.router.$state} $state
+   * @ngInject
+   */
+  constructor($state) { this.state_ = $state; }
+
+  /**
+   * @param {!backendApi.PersistentVolume} persistentVolume
+   * @return {string}
+   * @export
+   */
+  getPersistentVolumeDetailHref(persistentVolume) {
+    return this.state_.href(stateName, new StateParams(persistentVolume.objectMeta.name));
+  }
+
+  /**
+   * @param {!backendApi.ClaimReference} claim
+   * @return {string}
+   * @export
+   */
+  getClaimDetailHref(claim) {
+    return this.state_.href(claimStateName, new ClaimStateParams(claim.namespace, claim.name));
+  }
+}
+
+/**
+ * Definition object for the component that displays persistent volume card list.
+ *
+ * @type {!angular.Component}
+ */
+export const persistentVolumeCardListComponent = {
+  templateUrl: 'persistentvolumelist/persistentvolumecardlist.html',
+  controller: PersistentVolumeCardListController,
+  bindings: {
+    /** {!Array<!backendApi.PersistentVolume>} */
+    'persistentVolumes': '<',
+  },
+};
@@ -24,19 +24,27 @@ export default class ReplicationControllerDetailController {
   /**
    * @param {function(string):boolean} $mdMedia Angular Material $mdMedia service
    * @param {!backendApi.ReplicationControllerDetail} replicationControllerDetail
+   * @param {!angular.Resource<!backendApi.ReplicationControllerDetail>}
+   *     replicationControllerDetailResource
    * @param {!backendApi.Events} replicationControllerEvents
    * @param {!ui.router.$state} $state
    * @param {!../logs/logs_state.StateParams} $stateParams
+   * @param {!angular.Scope} $scope
+   * @param {!../common/watch/watch_service.WatchService} kdWatchService
    * @ngInject
    */
   constructor(
-      $mdMedia, replicationControllerDetail, replicationControllerEvents, $state, $stateParams) {
+      $mdMedia, replicationControllerDetail, replicationControllerDetailResource,
+      replicationControllerEvents, $state, $stateParams, $scope, kdWatchService) {
     /** @export {function(string):boolean} */
     this.mdMedia = $mdMedia;
 
     /** @export {!backendApi.ReplicationControllerDetail} */
     this.replicationControllerDetail = replicationControllerDetail;
 
+    /** @private {!angular.Resource<!backendApi.ReplicationControllerDetail>} */
+    this.replicationControllerDetailResource_ = replicationControllerDetailResource;
+
     /** @export !Array<!backendApi.Event> */
     this.events = replicationControllerEvents.events;
 
@@ -45,6 +53,23 @@ export default class ReplicationControllerDetailController {
 
     /** @private {!../logs/logs_state.StateParams} */
     this.stateParams_ = $stateParams;
+
+    // Pods of the replication controller are refreshed as soon as they change, e.g., when it
+    // is scaled.
+    kdWatchService.watch(
+        $scope, 'pods', this.stateParams_.namespace,
+        this.replicationControllerDetail.labelSelector, () => this.refresh_());
+  }
+
+  /**
+   * Reloads details of the replication controller.
+   * @private
+   */
+  refresh_() {
+    this.replicationControllerDetailResource_.get().$promise.then(
+        (replicationControllerDetail) => {
+          this.replicationControllerDetail = replicationControllerDetail;
+        });
   }
 
   /**
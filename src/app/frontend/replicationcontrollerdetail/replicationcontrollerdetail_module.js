@@ -17,6 +17,7 @@ import filtersModule from 'common/filters/filters_module';
 import logsModule from 'logs/logs_module';
 import podListModule from 'podlist/podlist_module';
 import serviceListModule from 'servicelist/servicelist_module';
+import watchModule from 'common/watch/watch_module';
 import stateConfig from './replicationcontrollerdetail_stateconfig';
 import {replicationControllerEventsComponent} from './replicationcontrollerevents_component';
 import {replicationControllerInfoComponent} from './replicationcontrollerinfo_component';
@@ -39,6 +40,7 @@ export default angular
           logsModule.name,
           podListModule.name,
           serviceListModule.name,
+          watchModule.name,
         ])
     .config(stateConfig)
     .component('kdReplicationControllerInfo', replicationControllerInfoComponent)
@@ -0,0 +1,77 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+/**
+ * Controller for the secret details view. Values of the secret are hidden until they are revealed
+ * one at a time.
+ *
+ * @final
+ */
+export class SecretDetailController {
+  /**
+   * @param {!backendApi.SecretDetail} secretDetail
+   * @param {!angular.$resource} $resource
+   * @param {!angular.$log} $log
+   * @ngInject
+   */
+  constructor(secretDetail, $resource, $log) {
+    /** @export {!backendApi.SecretDetail} */
+    this.secretDetail = secretDetail;
+
+    /**
+     * Revealed values of the secret, keyed by their keys.
+     * @export {!Object<string, string>}
+     */
+    this.values = {};
+
+    /** @private {!angular.$resource} */
+    this.resource_ = $resource;
+
+    /** @private {!angular.$log} */
+    this.log_ = $log;
+  }
+
+  /**
+   * @param {string} key
+   * @return {boolean}
+   * @export
+   */
+  isRevealed(key) { return this.values.hasOwnProperty(key); }
+
+  /**
+   * Fetches value of the given key and shows it.
+   *
+   * @param {string} key
+   * @export
+   */
+  reveal(key) {
+    let namespace = this.secretDetail.objectMeta.namespace;
+    let name = this.secretDetail.objectMeta.name;
+    /** @type {!angular.Resource<!backendApi.SecretValue>} */
+    let resource = this.resource_(`api/v1/secrets/${namespace}/${name}/data/:key`);
+
+    resource.get(
+        {'key': key}, (secretValue) => { this.values[key] = atob(secretValue.value); },
+        (err) => { this.log_.error('Error revealing secret value:', err); });
+  }
+
+  /**
+   * Hides value of the given key.
+   *
+   * @param {string} key
+   * @export
+   */
+  hide(key) { delete this.values[key]; }
+}
@@ -0,0 +1,65 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import {actionbarViewName} from 'chrome/chrome_state';
+import {breadcrumbsConfig} from 'common/components/breadcrumbs/breadcrumbs_component';
+import {stateName, stateUrl} from './secretdetail_state';
+import {SecretDetailController} from './secretdetail_controller';
+
+/**
+ * Configures states for the secret details view.
+ *
+ * @param {!ui.router.$stateProvider} $stateProvider
+ * @ngInject
+ */
+export default function stateConfig($stateProvider) {
+  $stateProvider.state(stateName, {
+    url: `${stateUrl}/:namespace/:secret`,
+    resolve: {
+      'secretDetailResource': getSecretDetailResource,
+      'secretDetail': resolveSecretDetail,
+    },
+    data: {
+      [breadcrumbsConfig]: {
+        'label': '{{$stateParams.secret}}',
+      },
+    },
+    views: {
+      '': {
+        controller: SecretDetailController,
+        controllerAs: 'ctrl',
+        templateUrl: 'secretdetail/secretdetail.html',
+      },
+      [actionbarViewName]: {},
+    },
+  });
+}
+
+/**
+ * @param {!./secretdetail_state.StateParams} $stateParams
+ * @param {!angular.$resource} $resource
+ * @return {!angular.Resource<!backendApi.SecretDetail>}
+ */
+export function getSecretDetailResource($stateParams, $resource) {
+  return $resource(`api/v1/secrets/${$stateParams.namespace}/${$stateParams.secret}`);
+}
+
+/**
+ * @param {!angular.Resource<!backendApi.SecretDetail>} secretDetailResource
+ * @return {!angular.$q.Promise}
+ */
+export function resolveSecretDetail(secretDetailResource) {
+  return secretDetailResource.get().$promise;
+}
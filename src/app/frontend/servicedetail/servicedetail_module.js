@@ -13,6 +13,7 @@
 // limitations under the License.
 
 import componentsModule from './../common/components/components_module';
+import podListModule from './../podlist/podlist_module';
 import stateConfig from './servicedetail_stateconfig';
 import {serviceInfoComponent} from './servicedetailinfo_component';
 
@@ -27,6 +28,7 @@ export default angular
         [
           'ngMaterial',
           componentsModule.name,
+          podListModule.name,
         ])
     .config(stateConfig)
     .component('kdServiceInfo', serviceInfoComponent);
@@ -17,6 +17,8 @@ import filtersModule from 'common/filters/filters_module';
 import componentsModule from 'common/components/components_module';
 import replicationControllerListModule from 'replicationcontrollerlist/replicationcontrollerlist_module';
 import replicaSetListModule from 'replicasetlist/replicasetlist_module';
+import jobListModule from 'joblist/joblist_module';
+import daemonSetListModule from 'daemonsetlist/daemonsetlist_module';
 
 /**
  */
@@ -31,5 +33,7 @@ export default angular
           componentsModule.name,
           replicationControllerListModule.name,
           replicaSetListModule.name,
+          jobListModule.name,
+          daemonSetListModule.name,
         ])
     .config(stateConfig);
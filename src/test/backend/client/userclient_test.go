@@ -0,0 +1,121 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
+)
+
+func TestParseAuthenticationMode(t *testing.T) {
+	cases := []struct {
+		name          string
+		expected      AuthenticationMode
+		expectedError bool
+	}{
+		{"shared", SharedAuthenticationMode, false},
+		{"per-user", PerUserAuthenticationMode, false},
+		{"foo", "", true},
+	}
+	for _, c := range cases {
+		actual, err := ParseAuthenticationMode(c.name)
+		if actual != c.expected || (err != nil) != c.expectedError {
+			t.Errorf("ParseAuthenticationMode(%#v) == %#v, %#v, expected %#v, error: %t",
+				c.name, actual, err, c.expected, c.expectedError)
+		}
+	}
+}
+
+func TestGetBearerToken(t *testing.T) {
+	cases := []struct {
+		authorization string
+		cookie        *http.Cookie
+		expected      string
+	}{
+		{"", nil, ""},
+		{"Bearer header-token", nil, "header-token"},
+		{"Basic dXNlcjpwYXNz", nil, ""},
+		{"", &http.Cookie{Name: TokenCookieName, Value: "cookie-token"}, "cookie-token"},
+		{
+			"Bearer header-token",
+			&http.Cookie{Name: TokenCookieName, Value: "cookie-token"},
+			"header-token",
+		},
+		{"", &http.Cookie{Name: "foo", Value: "bar"}, ""},
+	}
+	for _, c := range cases {
+		request, _ := http.NewRequest("GET", "/api/v1/pod", nil)
+		if len(c.authorization) > 0 {
+			request.Header.Set("Authorization", c.authorization)
+		}
+		if c.cookie != nil {
+			request.AddCookie(c.cookie)
+		}
+
+		actual := GetBearerToken(request)
+		if actual != c.expected {
+			t.Errorf("GetBearerToken(%#v, %#v) == %#v, expected %#v", c.authorization, c.cookie,
+				actual, c.expected)
+		}
+	}
+}
+
+func TestCreateUserClient(t *testing.T) {
+	config := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"cluster": {Server: "https://apiserver:443"},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"dashboard": {
+				Token:                 "dashboard-token",
+				ClientCertificateData: []byte("cert"),
+				ClientKeyData:         []byte("key"),
+			},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"context": {Cluster: "cluster", AuthInfo: "dashboard"},
+		},
+		CurrentContext: "context",
+	}
+	clientConfig := clientcmd.NewDefaultClientConfig(config, &clientcmd.ConfigOverrides{})
+
+	client, userClientConfig, err := CreateUserClient(clientConfig, "user-token")
+	if err != nil {
+		t.Fatalf("CreateUserClient() returned error: %s", err)
+	}
+	if client == nil {
+		t.Errorf("CreateUserClient() returned nil client")
+	}
+
+	cfg, err := userClientConfig.ClientConfig()
+	if err != nil {
+		t.Fatalf("ClientConfig() returned error: %s", err)
+	}
+	if cfg.Host != "https://apiserver:443" {
+		t.Errorf("Expected host of user client config to be %#v but got %#v",
+			"https://apiserver:443", cfg.Host)
+	}
+	if cfg.BearerToken != "user-token" {
+		t.Errorf("Expected token of user client config to be %#v but got %#v", "user-token",
+			cfg.BearerToken)
+	}
+	if cfg.CertData != nil || cfg.KeyData != nil {
+		t.Errorf("Expected user client config to drop dashboard certificates but got %#v, %#v",
+			cfg.CertData, cfg.KeyData)
+	}
+}
@@ -19,8 +19,12 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 )
 
 func TestFormatRequestLog(t *testing.T) {
@@ -71,3 +75,88 @@ func TestFormatResponseLog(t *testing.T) {
 		}
 	}
 }
+
+func TestGetLogOptions(t *testing.T) {
+	tailLines := int64(10)
+	sinceSeconds := int64(60)
+	limitBytes := int64(1024)
+	sinceTime := unversioned.Time{Time: time.Date(2016, 5, 10, 12, 0, 0, 0, time.UTC)}
+
+	cases := []struct {
+		query         string
+		expected      *api.PodLogOptions
+		expectedError bool
+	}{
+		{"", &api.PodLogOptions{}, false},
+		{
+			"tailLines=10&sinceSeconds=60&limitBytes=1024&previous=true&follow=true",
+			&api.PodLogOptions{
+				TailLines:    &tailLines,
+				SinceSeconds: &sinceSeconds,
+				LimitBytes:   &limitBytes,
+				Previous:     true,
+				Follow:       true,
+			},
+			false,
+		},
+		{"sinceTime=2016-05-10T12:00:00Z", &api.PodLogOptions{SinceTime: &sinceTime}, false},
+		{"tailLines=foo", nil, true},
+		{"limitBytes=0", nil, true},
+		{"sinceTime=yesterday", nil, true},
+		{"sinceSeconds=60&sinceTime=2016-05-10T12:00:00Z", nil, true},
+		{"previous=maybe", nil, true},
+	}
+	for _, c := range cases {
+		httpRequest, _ := http.NewRequest("GET", "/api/v1/logs/ns/pod?"+c.query, nil)
+		request := restful.NewRequest(httpRequest)
+
+		actual, err := getLogOptions(request)
+		if (err != nil) != c.expectedError {
+			t.Errorf("getLogOptions(%#v) returned error %#v, expected error: %t", c.query, err,
+				c.expectedError)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getLogOptions(%#v) == %#v, expected %#v", c.query, actual, c.expected)
+		}
+	}
+}
+
+func TestGetEventQuery(t *testing.T) {
+	since := unversioned.Time{Time: time.Date(2016, 5, 10, 12, 0, 0, 0, time.UTC)}
+	until := unversioned.Time{Time: time.Date(2016, 5, 11, 12, 0, 0, 0, time.UTC)}
+
+	cases := []struct {
+		query         string
+		expected      *event.EventQuery
+		expectedError bool
+	}{
+		{"", &event.EventQuery{}, false},
+		{
+			"type=Warning&reason=FailedScheduling&sinceTime=2016-05-10T12:00:00Z&" +
+				"untilTime=2016-05-11T12:00:00Z",
+			&event.EventQuery{
+				Type:   api.EventTypeWarning,
+				Reason: "FailedScheduling",
+				Since:  &since,
+				Until:  &until,
+			},
+			false,
+		},
+		{"type=Error", nil, true},
+		{"sinceTime=yesterday", nil, true},
+		{"untilTime=tomorrow", nil, true},
+	}
+	for _, c := range cases {
+		httpRequest, _ := http.NewRequest("GET", "/api/v1/events?"+c.query, nil)
+		request := restful.NewRequest(httpRequest)
+
+		actual, err := getEventQuery(request)
+		if (err != nil) != c.expectedError {
+			t.Errorf("getEventQuery(%#v) returned error %#v, expected error: %t", c.query, err,
+				c.expectedError)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getEventQuery(%#v) == %#v, expected %#v", c.query, actual, c.expected)
+		}
+	}
+}
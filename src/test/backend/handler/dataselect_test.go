@@ -0,0 +1,79 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	restful "github.com/emicklei/go-restful"
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+func TestGetDataSelectQuery(t *testing.T) {
+	selector, _ := labels.Parse("app=nginx,tier!=db")
+
+	cases := []struct {
+		query         string
+		expected      *common.DataSelectQuery
+		expectedError bool
+	}{
+		{"", common.NoDataSelect, false},
+		{
+			"itemsPerPage=10&page=3&sortBy=status,-creationTimestamp&filterBy=name:web&" +
+				"filterBy=labelSelector:app%3Dnginx,tier!%3Ddb",
+			&common.DataSelectQuery{
+				PaginationQuery: &common.PaginationQuery{ItemsPerPage: 10, Page: 3},
+				SortQuery: &common.SortQuery{SortByList: []common.SortBy{
+					{Property: common.StatusProperty, Ascending: true},
+					{Property: common.CreationTimestampProperty, Ascending: false},
+				}},
+				FilterQuery: &common.FilterQuery{Name: "web", LabelSelector: selector},
+			},
+			false,
+		},
+		{
+			"itemsPerPage=20",
+			&common.DataSelectQuery{
+				PaginationQuery: &common.PaginationQuery{ItemsPerPage: 20, Page: 1},
+				SortQuery:       common.NoSort,
+				FilterQuery:     common.NoFilter,
+			},
+			false,
+		},
+		{"itemsPerPage=0", nil, true},
+		{"itemsPerPage=10&page=foo", nil, true},
+		{"sortBy=size", nil, true},
+		{"sortBy=name,", nil, true},
+		{"filterBy=web", nil, true},
+		{"filterBy=image:nginx", nil, true},
+		{"filterBy=labelSelector:app%3D%3D%3D", nil, true},
+	}
+	for _, c := range cases {
+		httpRequest, _ := http.NewRequest("GET", "/api/v1/pods?"+c.query, nil)
+		request := restful.NewRequest(httpRequest)
+
+		actual, err := getDataSelectQuery(request)
+		if (err != nil) != c.expectedError {
+			t.Errorf("getDataSelectQuery(%#v) returned error %#v, expected error: %t", c.query, err,
+				c.expectedError)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getDataSelectQuery(%#v) == %#v, expected %#v", c.query, actual, c.expected)
+		}
+	}
+}
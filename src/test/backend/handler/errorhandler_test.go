@@ -0,0 +1,90 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+func TestGetErrorResponse(t *testing.T) {
+	connectionError := &url.Error{
+		Op:  "Get",
+		URL: "http://localhost:8080/api",
+		Err: errors.New("connection refused"),
+	}
+
+	cases := []struct {
+		err      error
+		expected ErrorResponse
+	}{
+		{
+			k8serrors.NewNotFound(api.Resource("pods"), "foo"),
+			ErrorResponse{
+				Code:    http.StatusNotFound,
+				Reason:  unversioned.StatusReasonNotFound,
+				Message: `pods "foo" not found`,
+				Details: &unversioned.StatusDetails{Name: "foo", Kind: "pods"},
+			},
+		},
+		{
+			k8serrors.NewForbidden(api.Resource("secrets"), "foo", errors.New("denied")),
+			ErrorResponse{
+				Code:    http.StatusForbidden,
+				Reason:  unversioned.StatusReasonForbidden,
+				Message: `secrets "foo" is forbidden: denied`,
+				Details: &unversioned.StatusDetails{Name: "foo", Kind: "secrets"},
+			},
+		},
+		{
+			k8serrors.NewAlreadyExists(api.Resource("services"), "foo"),
+			ErrorResponse{
+				Code:    http.StatusConflict,
+				Reason:  unversioned.StatusReasonAlreadyExists,
+				Message: `services "foo" already exists`,
+				Details: &unversioned.StatusDetails{Name: "foo", Kind: "services"},
+			},
+		},
+		{
+			connectionError,
+			ErrorResponse{
+				Code:    http.StatusServiceUnavailable,
+				Reason:  unversioned.StatusReasonServiceUnavailable,
+				Message: connectionError.Error(),
+			},
+		},
+		{
+			errors.New("foo"),
+			ErrorResponse{
+				Code:    http.StatusInternalServerError,
+				Reason:  unversioned.StatusReasonInternalError,
+				Message: "foo",
+			},
+		},
+	}
+	for _, c := range cases {
+		actual := getErrorResponse(c.err)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getErrorResponse(%#v) == %#v, expected %#v", c.err, actual, c.expected)
+		}
+	}
+}
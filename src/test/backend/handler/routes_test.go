@@ -0,0 +1,323 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/kubernetes/dashboard/client"
+	. "github.com/kubernetes/dashboard/resource/replicationcontroller"
+	. "github.com/kubernetes/dashboard/resource/secret"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
+)
+
+// Creates API handler backed by the given fake Apiserver.
+func newTestApiHandler(t *testing.T, apiserver *httptest.Server,
+	authenticationMode AuthenticationMode) http.Handler {
+
+	clientConfig := clientcmd.NewDefaultClientConfig(clientcmdapi.Config{},
+		&clientcmd.ConfigOverrides{ClusterInfo: clientcmdapi.Cluster{Server: apiserver.URL}})
+	cfg, err := clientConfig.ClientConfig()
+	if err != nil {
+		t.Fatalf("ClientConfig() returned unexpected error: %v", err)
+	}
+	apiClient, err := client.New(cfg)
+	if err != nil {
+		t.Fatalf("client.New(%#v) returned unexpected error: %v", cfg, err)
+	}
+	return CreateHttpApiHandler(apiClient, nil, clientConfig, authenticationMode)
+}
+
+// Serves a single request through the given handler.
+func serveRequest(handler http.Handler, method string, url string, body string,
+	header http.Header) *httptest.ResponseRecorder {
+
+	request, _ := http.NewRequest(method, url, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	for name, values := range header {
+		request.Header[name] = values
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestDeployFromFileRoute(t *testing.T) {
+	apiserver := httptest.NewServer(http.NotFoundHandler())
+	defer apiserver.Close()
+
+	body := `{"name": "empty.yaml", "content": "", "dryRun": true}`
+	cases := []struct {
+		authenticationMode AuthenticationMode
+		header             http.Header
+		expected           int
+	}{
+		{SharedAuthenticationMode, nil, http.StatusOK},
+		{PerUserAuthenticationMode, nil, http.StatusUnauthorized},
+		{PerUserAuthenticationMode, http.Header{"Authorization": {"Bearer abc"}}, http.StatusOK},
+	}
+	for _, c := range cases {
+		handler := newTestApiHandler(t, apiserver, c.authenticationMode)
+		actual := serveRequest(handler, "POST", "/api/v1/appdeploymentfromfile", body, c.header)
+		if actual.Code != c.expected {
+			t.Errorf("POST /api/v1/appdeploymentfromfile in %s mode with header %#v returned %d, "+
+				"expected %d: %s", c.authenticationMode, c.header, actual.Code, c.expected,
+				actual.Body.String())
+		}
+	}
+}
+
+func TestLoginRouteCookie(t *testing.T) {
+	apiserver := httptest.NewServer(http.NotFoundHandler())
+	defer apiserver.Close()
+	handler := newTestApiHandler(t, apiserver, PerUserAuthenticationMode)
+
+	actual := serveRequest(handler, "POST", "/api/v1/login", `{"token": "abc"}`, nil)
+	if actual.Code != http.StatusOK {
+		t.Fatalf("POST /api/v1/login returned %d, expected %d", actual.Code, http.StatusOK)
+	}
+
+	cookie := actual.Header().Get("Set-Cookie")
+	for _, attribute := range []string{TokenCookieName + "=abc", "HttpOnly", "SameSite=Strict"} {
+		if !strings.Contains(cookie, attribute) {
+			t.Errorf("POST /api/v1/login set cookie %q, expected it to contain %q", cookie, attribute)
+		}
+	}
+}
+
+// Creates fake Apiserver that serves a single secret foo in default namespace and records
+// Authorization headers of the requests it receives.
+func newFakeSecretApiserver(authorizations *[]string) *httptest.Server {
+	secret := `{"kind": "Secret", "apiVersion": "v1", "metadata": {"name": "foo", ` +
+		`"namespace": "default"}, "type": "Opaque", "data": {"key": "dmFsdWU="}}`
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*authorizations = append(*authorizations, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/api/v1/namespaces/default/secrets" && r.Method == "GET":
+			w.Write([]byte(`{"kind": "SecretList", "apiVersion": "v1", "items": [` + secret + `]}`))
+		case r.URL.Path == "/api/v1/namespaces/default/pods" && r.Method == "GET":
+			w.Write([]byte(`{"kind": "PodList", "apiVersion": "v1", "items": []}`))
+		case r.URL.Path == "/api/v1/namespaces/default/secrets/foo" && r.Method == "GET":
+			w.Write([]byte(secret))
+		case r.URL.Path == "/api/v1/namespaces/default/secrets/foo" && r.Method == "DELETE":
+			w.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Success"}`))
+		case r.Method == "POST" || r.Method == "PUT":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(secret))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", ` +
+				`"reason": "NotFound", "code": 404}`))
+		}
+	}))
+}
+
+func TestSecretRoutes(t *testing.T) {
+	spec := `{"name": "foo", "data": {"key": "dmFsdWU="}}`
+	cases := []struct {
+		method   string
+		url      string
+		body     string
+		expected int
+	}{
+		{"GET", "/api/v1/secrets/default", "", http.StatusOK},
+		{"POST", "/api/v1/secrets/default", spec, http.StatusCreated},
+		{"GET", "/api/v1/secrets/default/foo", "", http.StatusOK},
+		{"PUT", "/api/v1/secrets/default/foo", spec, http.StatusOK},
+		{"DELETE", "/api/v1/secrets/default/foo", "", http.StatusOK},
+		{"GET", "/api/v1/secrets/default/foo/data/key", "", http.StatusOK},
+		{"GET", "/api/v1/secrets/default/foo/data/missing", "", http.StatusNotFound},
+	}
+
+	authorizations := []string{}
+	apiserver := newFakeSecretApiserver(&authorizations)
+	defer apiserver.Close()
+	sharedHandler := newTestApiHandler(t, apiserver, SharedAuthenticationMode)
+	perUserHandler := newTestApiHandler(t, apiserver, PerUserAuthenticationMode)
+
+	for _, c := range cases {
+		actual := serveRequest(sharedHandler, c.method, c.url, c.body, nil)
+		if actual.Code != c.expected {
+			t.Errorf("%s %s in shared mode returned %d, expected %d: %s", c.method, c.url,
+				actual.Code, c.expected, actual.Body.String())
+		}
+
+		actual = serveRequest(perUserHandler, c.method, c.url, c.body, nil)
+		if actual.Code != http.StatusUnauthorized {
+			t.Errorf("%s %s in per-user mode without token returned %d, expected %d", c.method,
+				c.url, actual.Code, http.StatusUnauthorized)
+		}
+	}
+}
+
+func TestSecretValueRouteUsesUserClient(t *testing.T) {
+	authorizations := []string{}
+	apiserver := newFakeSecretApiserver(&authorizations)
+	defer apiserver.Close()
+	handler := newTestApiHandler(t, apiserver, PerUserAuthenticationMode)
+
+	actual := serveRequest(handler, "GET", "/api/v1/secrets/default/foo/data/key", "",
+		http.Header{"Authorization": {"Bearer abc"}})
+	if actual.Code != http.StatusOK {
+		t.Fatalf("GET secret value returned %d, expected %d: %s", actual.Code, http.StatusOK,
+			actual.Body.String())
+	}
+	value := new(SecretValue)
+	err := json.Unmarshal(actual.Body.Bytes(), value)
+	if err != nil || string(value.Value) != "value" {
+		t.Errorf("GET secret value returned %s, expected value of key", actual.Body.String())
+	}
+	if !reflect.DeepEqual(authorizations, []string{"Bearer abc"}) {
+		t.Errorf("Apiserver received Authorization headers %#v, expected %#v", authorizations,
+			[]string{"Bearer abc"})
+	}
+}
+
+func TestDeployFromFileRouteWithUnsupportedMode(t *testing.T) {
+	apiserver := httptest.NewServer(http.NotFoundHandler())
+	defer apiserver.Close()
+	handler := newTestApiHandler(t, apiserver, SharedAuthenticationMode)
+
+	body := `{"name": "empty.yaml", "content": "", "mode": "foo-mode", "dryRun": true}`
+	actual := serveRequest(handler, "POST", "/api/v1/appdeploymentfromfile", body, nil)
+	if actual.Code != http.StatusBadRequest {
+		t.Errorf("POST /api/v1/appdeploymentfromfile with unsupported mode returned %d, "+
+			"expected %d", actual.Code, http.StatusBadRequest)
+	}
+}
+
+func TestDeployFromFileRouteReportsInvalidObjects(t *testing.T) {
+	// Apiserver has to announce supported versions for the file to be decoded.
+	apiserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api":
+			w.Write([]byte(`{"kind": "APIVersions", "versions": ["v1"]}`))
+		case "/apis":
+			w.Write([]byte(`{"kind": "APIGroupList", "groups": []}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer apiserver.Close()
+	handler := newTestApiHandler(t, apiserver, SharedAuthenticationMode)
+
+	body := `{"name": "invalid.yaml", "content": "foo-content-invalid", "dryRun": true}`
+	actual := serveRequest(handler, "POST", "/api/v1/appdeploymentfromfile", body, nil)
+	if actual.Code != http.StatusOK {
+		t.Fatalf("POST /api/v1/appdeploymentfromfile returned %d, expected %d: %s", actual.Code,
+			http.StatusOK, actual.Body.String())
+	}
+
+	deployment := new(AppDeploymentFromFileResponse)
+	if err := json.Unmarshal(actual.Body.Bytes(), deployment); err != nil {
+		t.Fatalf("POST /api/v1/appdeploymentfromfile returned invalid response: %v", err)
+	}
+	if len(deployment.Results) != 1 || deployment.Results[0].Status != ObjectFailed ||
+		len(deployment.Results[0].Error) == 0 {
+		t.Errorf("POST /api/v1/appdeploymentfromfile returned results %#v, expected a single "+
+			"failed result with an error", deployment.Results)
+	}
+}
+
+func TestDeployRouteReturnsReport(t *testing.T) {
+	// Apiserver accepts every created object as is.
+	apiserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		io.Copy(w, r.Body)
+	}))
+	defer apiserver.Close()
+	handler := newTestApiHandler(t, apiserver, SharedAuthenticationMode)
+
+	body := `{"name": "foo", "namespace": "default", "containerImage": "nginx", "replicas": 1, ` +
+		`"kind": "ReplicationController"}`
+	actual := serveRequest(handler, "POST", "/api/v1/appdeployments", body, nil)
+	if actual.Code != http.StatusCreated {
+		t.Fatalf("POST /api/v1/appdeployments returned %d, expected %d: %s", actual.Code,
+			http.StatusCreated, actual.Body.String())
+	}
+
+	report := new(AppDeploymentReport)
+	if err := json.Unmarshal(actual.Body.Bytes(), report); err != nil {
+		t.Fatalf("POST /api/v1/appdeployments returned invalid response: %v", err)
+	}
+	expected := []DeployedObject{{Kind: "ReplicationController", Name: "foo", Namespace: "default"}}
+	if !reflect.DeepEqual(report.Created, expected) {
+		t.Errorf("POST /api/v1/appdeployments reported created objects %#v, expected %#v",
+			report.Created, expected)
+	}
+}
+
+func TestDeleteRoutesWithInvalidDeleteServices(t *testing.T) {
+	apiserver := httptest.NewServer(http.NotFoundHandler())
+	defer apiserver.Close()
+	handler := newTestApiHandler(t, apiserver, SharedAuthenticationMode)
+
+	cases := []string{
+		"/api/v1/replicationcontrollers/default/foo",
+		"/api/v1/replicationcontrollers/default/foo?deleteServices=foo",
+		"/api/v1/replicasets/default/foo",
+		"/api/v1/replicasets/default/foo?deleteServices=foo",
+		"/api/v1/deployments/default/foo",
+		"/api/v1/deployments/default/foo?deleteServices=foo",
+	}
+	for _, url := range cases {
+		actual := serveRequest(handler, "DELETE", url, "", nil)
+		if actual.Code != http.StatusBadRequest {
+			t.Errorf("DELETE %s returned %d, expected %d", url, actual.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestLogsRouteReturnsApiserverError(t *testing.T) {
+	apiserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default/pods/foo":
+			w.Write([]byte(`{"kind": "Pod", "apiVersion": "v1", "metadata": {"name": "foo", ` +
+				`"namespace": "default"}, "spec": {"containers": [{"name": "bar"}]}}`))
+		case "/api/v1/namespaces/default/pods/foo/log":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", ` +
+				`"message": "previous terminated container \"bar\" not found", ` +
+				`"reason": "BadRequest", "code": 400}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer apiserver.Close()
+	handler := newTestApiHandler(t, apiserver, SharedAuthenticationMode)
+
+	actual := serveRequest(handler, "GET", "/api/v1/logs/default/foo?previous=true", "", nil)
+	if actual.Code != http.StatusBadRequest {
+		t.Errorf("GET /api/v1/logs/default/foo?previous=true returned %d, expected %d: %s",
+			actual.Code, http.StatusBadRequest, actual.Body.String())
+	}
+}
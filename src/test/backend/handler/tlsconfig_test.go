@@ -0,0 +1,102 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Writes PEM encoded certificate and private key of a generated self-signed certificate to the
+// given directory and returns their paths.
+func writeSelfSignedCertificate(t *testing.T, dir string) (string, string) {
+	certificate, err := GenerateSelfSignedCertificate()
+	if err != nil {
+		t.Fatalf("GenerateSelfSignedCertificate() returned error: %s", err)
+	}
+
+	certFile := filepath.Join(dir, "dashboard.crt")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Certificate[0]})
+	if err := ioutil.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	keyFile := filepath.Join(dir, "dashboard.key")
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(certificate.PrivateKey.(*rsa.PrivateKey)),
+	})
+	if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile, keyFile
+}
+
+func TestCreateTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dashboard-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := writeSelfSignedCertificate(t, dir)
+
+	cases := []struct {
+		spec               TLSConfigSpec
+		expectedTLS        bool
+		expectedClientAuth tls.ClientAuthType
+		expectedError      bool
+	}{
+		{TLSConfigSpec{}, false, tls.NoClientCert, false},
+		{TLSConfigSpec{CertFile: certFile}, false, tls.NoClientCert, true},
+		{TLSConfigSpec{ClientCAFile: certFile}, false, tls.NoClientCert, true},
+		{TLSConfigSpec{CertFile: certFile, KeyFile: keyFile}, true, tls.NoClientCert, false},
+		{TLSConfigSpec{CertFile: keyFile, KeyFile: certFile}, false, tls.NoClientCert, true},
+		{TLSConfigSpec{AutoGenerateCertificates: true}, true, tls.NoClientCert, false},
+		{
+			TLSConfigSpec{AutoGenerateCertificates: true, ClientCAFile: certFile},
+			true, tls.RequireAndVerifyClientCert, false,
+		},
+		{
+			TLSConfigSpec{AutoGenerateCertificates: true, ClientCAFile: keyFile},
+			false, tls.NoClientCert, true,
+		},
+	}
+	for _, c := range cases {
+		actual, err := CreateTLSConfig(c.spec)
+		if (err != nil) != c.expectedError {
+			t.Errorf("CreateTLSConfig(%#v) returned error %#v, expected error: %t", c.spec, err,
+				c.expectedError)
+		}
+		if (actual != nil) != c.expectedTLS {
+			t.Errorf("CreateTLSConfig(%#v) == %#v, expected TLS config: %t", c.spec, actual,
+				c.expectedTLS)
+		}
+		if actual != nil && len(actual.Certificates) != 1 {
+			t.Errorf("CreateTLSConfig(%#v) returned %d certificates, expected 1", c.spec,
+				len(actual.Certificates))
+		}
+		if actual != nil && actual.ClientAuth != c.expectedClientAuth {
+			t.Errorf("CreateTLSConfig(%#v) returned client auth %#v, expected %#v", c.spec,
+				actual.ClientAuth, c.expectedClientAuth)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+type testCell struct {
+	api.ObjectMeta
+	status string
+}
+
+func (c testCell) GetObjectMeta() api.ObjectMeta {
+	return c.ObjectMeta
+}
+
+func (c testCell) GetProperty(name PropertyName) ComparableValue {
+	if name == StatusProperty && len(c.status) > 0 {
+		return StdComparableString(c.status)
+	}
+	return nil
+}
+
+func TestSelectData(t *testing.T) {
+	a := testCell{api.ObjectMeta{Name: "a", CreationTimestamp: unversioned.Unix(3, 0),
+		Labels: map[string]string{"app": "web"}}, "Running"}
+	b := testCell{api.ObjectMeta{Name: "b", CreationTimestamp: unversioned.Unix(1, 0),
+		Labels: map[string]string{"app": "db"}}, "Pending"}
+	c := testCell{api.ObjectMeta{Name: "web-c", CreationTimestamp: unversioned.Unix(2, 0),
+		Labels: map[string]string{"app": "web"}}, "Running"}
+	d := testCell{api.ObjectMeta{Name: "d", CreationTimestamp: unversioned.Unix(4, 0)}, ""}
+	cells := []DataCell{c, a, d, b}
+	webSelector, _ := labels.Parse("app=web")
+
+	cases := []struct {
+		query            *DataSelectQuery
+		expected         []DataCell
+		expectedListMeta ListMeta
+	}{
+		{NoDataSelect, []DataCell{c, a, d, b}, ListMeta{TotalItems: 4}},
+		{
+			&DataSelectQuery{NoPagination, &SortQuery{[]SortBy{{NameProperty, true}}}, NoFilter},
+			[]DataCell{a, b, d, c},
+			ListMeta{TotalItems: 4},
+		},
+		{
+			&DataSelectQuery{NoPagination,
+				&SortQuery{[]SortBy{{CreationTimestampProperty, false}}}, NoFilter},
+			[]DataCell{d, a, c, b},
+			ListMeta{TotalItems: 4},
+		},
+		{
+			&DataSelectQuery{NoPagination,
+				&SortQuery{[]SortBy{{StatusProperty, true}, {NameProperty, false}}}, NoFilter},
+			[]DataCell{b, c, a, d},
+			ListMeta{TotalItems: 4},
+		},
+		{
+			&DataSelectQuery{&PaginationQuery{ItemsPerPage: 3, Page: 2},
+				&SortQuery{[]SortBy{{NameProperty, true}}}, NoFilter},
+			[]DataCell{c},
+			ListMeta{TotalItems: 4},
+		},
+		{
+			&DataSelectQuery{&PaginationQuery{ItemsPerPage: 3, Page: 3}, NoSort, NoFilter},
+			[]DataCell{},
+			ListMeta{TotalItems: 4},
+		},
+		{
+			&DataSelectQuery{NoPagination, NoSort,
+				&FilterQuery{Name: "WEB", LabelSelector: labels.Everything()}},
+			[]DataCell{c},
+			ListMeta{TotalItems: 1},
+		},
+		{
+			&DataSelectQuery{&PaginationQuery{ItemsPerPage: 1, Page: 1}, NoSort,
+				&FilterQuery{LabelSelector: webSelector}},
+			[]DataCell{c},
+			ListMeta{TotalItems: 2},
+		},
+	}
+	for _, testCase := range cases {
+		input := make([]DataCell, len(cells))
+		copy(input, cells)
+
+		actual, actualListMeta := SelectData(input, testCase.query)
+		if !reflect.DeepEqual(actual, testCase.expected) {
+			t.Errorf("SelectData(%#v) == %#v, expected %#v", testCase.query, actual,
+				testCase.expected)
+		}
+		if !reflect.DeepEqual(actualListMeta, testCase.expectedListMeta) {
+			t.Errorf("SelectData(%#v) returned %#v list meta, expected %#v", testCase.query,
+				actualListMeta, testCase.expectedListMeta)
+		}
+	}
+}
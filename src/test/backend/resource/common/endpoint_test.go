@@ -0,0 +1,258 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestGetExternalEndpoint(t *testing.T) {
+	cases := []struct {
+		serviceIp api.LoadBalancerIngress
+		ports     []api.ServicePort
+		expected  Endpoint
+	}{
+		{api.LoadBalancerIngress{IP: "127.0.0.1"}, nil, Endpoint{Host: "127.0.0.1"}},
+		{api.LoadBalancerIngress{IP: "127.0.0.1", Hostname: "host"}, nil, Endpoint{Host: "host"}},
+		{api.LoadBalancerIngress{IP: "127.0.0.1"},
+			[]api.ServicePort{{Name: "foo", Port: 8080, Protocol: "TCP"}},
+			Endpoint{Host: "127.0.0.1", Ports: []ServicePort{{Port: 8080, Protocol: "TCP"}}}},
+	}
+	for _, c := range cases {
+		actual := getExternalEndpoint(c.serviceIp, c.ports)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getExternalEndpoint(%+v, %+v) == %+v, expected %+v",
+				c.serviceIp, c.ports, actual, c.expected)
+		}
+	}
+}
+
+func TestIsExternalIPUniqe(t *testing.T) {
+	cases := []struct {
+		externalIPs []string
+		externalIP  string
+		expected    bool
+	}{
+		{
+			[]string{"127.0.0.1", "192.168.1.1"},
+			"172.0.0.1",
+			true,
+		},
+		{
+			[]string{"127.0.0.1", "192.168.1.1", "172.0.0.1"},
+			"172.0.0.1",
+			false,
+		},
+		{
+			[]string{},
+			"172.0.0.1",
+			true,
+		},
+	}
+	for _, c := range cases {
+		actual := isExternalIPUniqe(c.externalIPs, c.externalIP)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("isExternalIPUniqe(%+v, %+v) == %+v, expected %+v", c.externalIPs,
+				c.externalIP, actual, c.expected)
+		}
+	}
+}
+
+func TestGetExternalEndpoints(t *testing.T) {
+	ports := []api.ServicePort{{Protocol: "TCP", Port: 80, NodePort: 30100}}
+	cases := []struct {
+		service  api.Service
+		expected []Endpoint
+	}{
+		{
+			api.Service{Spec: api.ServiceSpec{Type: api.ServiceTypeClusterIP, Ports: ports}},
+			nil,
+		},
+		{
+			api.Service{Spec: api.ServiceSpec{
+				Type:        api.ServiceTypeClusterIP,
+				Ports:       ports,
+				ExternalIPs: []string{"192.168.1.110"},
+			}},
+			[]Endpoint{
+				{Host: "192.168.1.110", Ports: []ServicePort{{Port: 80, Protocol: "TCP"}}},
+			},
+		},
+		{
+			api.Service{
+				Spec: api.ServiceSpec{
+					Type:        api.ServiceTypeLoadBalancer,
+					Ports:       ports,
+					ExternalIPs: []string{"192.168.1.110"},
+				},
+				Status: api.ServiceStatus{LoadBalancer: api.LoadBalancerStatus{
+					Ingress: []api.LoadBalancerIngress{{Hostname: "example.com"}},
+				}},
+			},
+			[]Endpoint{
+				{Host: "192.168.1.110", Ports: []ServicePort{{Port: 80, Protocol: "TCP"}}},
+				{Host: "example.com", Ports: []ServicePort{{Port: 80, Protocol: "TCP"}}},
+			},
+		},
+		{
+			api.Service{Spec: api.ServiceSpec{Type: api.ServiceTypeNodePort, Ports: ports}},
+			[]Endpoint{
+				{Host: "localhost", Ports: []ServicePort{{Port: 30100, Protocol: "TCP"}}},
+			},
+		},
+	}
+	for _, c := range cases {
+		actual := GetExternalEndpoints([]api.Pod{}, c.service, []api.Node{})
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetExternalEndpoints(%+v) == %+v, expected %+v", c.service, actual,
+				c.expected)
+		}
+	}
+}
+
+func TestGetNodePortEndpoints(t *testing.T) {
+	cases := []struct {
+		pods     []api.Pod
+		service  api.Service
+		nodes    []api.Node
+		expected []Endpoint
+	}{
+		{
+			[]api.Pod{
+				{
+					Status: api.PodStatus{
+						HostIP: "192.168.1.108",
+					},
+				},
+				{
+					Status: api.PodStatus{
+						HostIP: "192.168.1.108",
+					},
+				},
+				{
+					Status: api.PodStatus{
+						HostIP: "192.168.1.109",
+					},
+				},
+			},
+			api.Service{
+				Spec: api.ServiceSpec{
+					Type: api.ServiceTypeNodePort,
+					Ports: []api.ServicePort{
+						{
+							Protocol: "TCP",
+							NodePort: 30100,
+						},
+						{
+							Protocol: "TCP",
+							NodePort: 30101,
+						},
+					},
+				},
+			},
+			[]api.Node{
+				{
+					Status: api.NodeStatus{
+						Addresses: []api.NodeAddress{
+							{
+								Type:    api.NodeExternalIP,
+								Address: "192.168.1.108",
+							},
+						},
+					},
+				},
+			},
+			[]Endpoint{
+				{
+					Host: "192.168.1.108",
+					Ports: []ServicePort{
+						{
+							Port: 30100, Protocol: "TCP",
+						},
+					},
+				},
+				{
+					Host: "192.168.1.108",
+					Ports: []ServicePort{
+						{
+							Port: 30101, Protocol: "TCP",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		actual := getNodePortEndpoints(c.pods, c.service, c.nodes)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getNodePortEndpoints(%+v, %+v, %+v) == %+v, expected %+v", c.pods, c.service,
+				c.nodes, actual, c.expected)
+		}
+	}
+}
+
+func TestGetLocalhostEndpoints(t *testing.T) {
+	cases := []struct {
+		service  api.Service
+		expected []Endpoint
+	}{
+		{
+			api.Service{
+				Spec: api.ServiceSpec{
+					Ports: []api.ServicePort{
+						{
+							Protocol: "TCP",
+							NodePort: 30100,
+						},
+						{
+							Protocol: "TCP",
+							NodePort: 30101,
+						},
+					},
+				},
+			},
+			[]Endpoint{
+				{
+					Host: "localhost",
+					Ports: []ServicePort{
+						{
+							Port:     30100,
+							Protocol: "TCP",
+						},
+					},
+				},
+				{
+					Host: "localhost",
+					Ports: []ServicePort{
+						{
+							Port:     30101,
+							Protocol: "TCP",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		actual := getLocalhostEndpoints(c.service)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getLocalhostEndpoints(%+v) == %+v, expected %+v", c.service, actual,
+				c.expected)
+		}
+	}
+}
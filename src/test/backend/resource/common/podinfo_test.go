@@ -117,6 +117,30 @@ func TestGetMatchingPods(t *testing.T) {
 				},
 			}},
 		},
+		{
+			&unversioned.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			api.NamespaceDefault,
+			[]api.Pod{{
+				ObjectMeta: api.ObjectMeta{
+					Name:      "first",
+					Labels:    map[string]string{"foo": "baz"},
+					Namespace: api.NamespaceDefault,
+				},
+			}, {
+				ObjectMeta: api.ObjectMeta{
+					Name:      "second",
+					Labels:    map[string]string{"foo": "bar"},
+					Namespace: api.NamespaceDefault,
+				},
+			}},
+			[]api.Pod{{
+				ObjectMeta: api.ObjectMeta{
+					Name:      "second",
+					Labels:    map[string]string{"foo": "bar"},
+					Namespace: api.NamespaceDefault,
+				},
+			}},
+		},
 	}
 	for _, c := range cases {
 		actual := GetMatchingPods(c.labelSelector, c.namespace, c.pods)
@@ -0,0 +1,88 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/util/wait"
+)
+
+func TestDisabledResourceCache(t *testing.T) {
+	var resourceCache *ResourceCache
+
+	if list, ok := resourceCache.PodList(api.NamespaceAll); ok || list != nil {
+		t.Errorf("PodList() == %#v, %t, expected nil, false", list, ok)
+	}
+	if list, ok := resourceCache.NodeList(); ok || list != nil {
+		t.Errorf("NodeList() == %#v, %t, expected nil, false", list, ok)
+	}
+}
+
+func TestGetPodListChannelFromResourceCache(t *testing.T) {
+	cachedPods := &api.PodList{
+		Items: []api.Pod{
+			{ObjectMeta: api.ObjectMeta{Name: "cached-pod", Namespace: "foo"}},
+		},
+	}
+	resourceCache := NewResourceCache(testclient.NewSimpleFake(cachedPods), time.Minute)
+
+	// Before the cache is synced, pods are listed directly.
+	UseResourceCache(resourceCache)
+	defer UseResourceCache(nil)
+	channel := GetPodListChannel(testclient.NewSimpleFake(&api.PodList{}), api.NamespaceAll, 1)
+	if pods := <-channel.List; len(pods.Items) != 0 {
+		t.Errorf("Expected pods to be listed directly before sync, got %#v", pods.Items)
+	}
+	<-channel.Error
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	resourceCache.Run(stopCh)
+	err := wait.Poll(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return resourceCache.HasSynced(), nil
+	})
+	if err != nil {
+		t.Fatalf("Resource cache has not synced: %s", err)
+	}
+
+	cases := []struct {
+		namespace string
+		expected  []api.Pod
+	}{
+		{api.NamespaceAll, cachedPods.Items},
+		{"foo", cachedPods.Items},
+		{"bar", []api.Pod{}},
+	}
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake(&api.PodList{})
+		channel = GetPodListChannel(fakeClient, c.namespace, 1)
+		pods := <-channel.List
+		if err := <-channel.Error; err != nil {
+			t.Errorf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(pods.Items, c.expected) {
+			t.Errorf("GetPodListChannel(%#v) == %#v, expected %#v", c.namespace, pods.Items,
+				c.expected)
+		}
+		if len(fakeClient.Actions()) != 0 {
+			t.Errorf("Expected no calls to the apiserver, got %#v", fakeClient.Actions())
+		}
+	}
+}
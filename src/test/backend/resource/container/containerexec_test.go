@@ -0,0 +1,115 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestGetExecOptions(t *testing.T) {
+	pod := &api.Pod{
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "container-1"}, {Name: "container-2"}},
+		},
+	}
+
+	cases := []struct {
+		pod      *api.Pod
+		spec     ExecSpec
+		expected *api.PodExecOptions
+	}{
+		{
+			pod,
+			ExecSpec{TTY: true},
+			&api.PodExecOptions{
+				Container: "container-1",
+				Command:   DefaultExecCommand,
+				Stdin:     true,
+				Stdout:    true,
+				TTY:       true,
+			},
+		},
+		{
+			pod,
+			ExecSpec{Container: "container-2", Command: []string{"ls", "-l"}},
+			&api.PodExecOptions{
+				Container: "container-2",
+				Command:   []string{"ls", "-l"},
+				Stdin:     true,
+				Stdout:    true,
+				Stderr:    true,
+			},
+		},
+	}
+	for _, c := range cases {
+		actual := getExecOptions(c.pod, c.spec)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getExecOptions(%#v, %#v) == %#v, expected %#v",
+				c.pod, c.spec, actual, c.expected)
+		}
+	}
+}
+
+func TestTerminalSession(t *testing.T) {
+	output := make(chan TerminalMessage)
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		session := NewTerminalSession(conn, time.Minute)
+		defer session.Close()
+
+		input, _ := ioutil.ReadAll(session)
+		session.Stdout().Write(input)
+		session.Stderr().Write([]byte("done"))
+	}))
+	defer server.Close()
+	go func() {
+		defer close(output)
+		conn, err := websocket.Dial("ws"+server.URL[len("http"):], "", server.URL)
+		if err != nil {
+			t.Errorf("Cannot connect to terminal session: %s", err)
+			return
+		}
+		websocket.JSON.Send(conn, TerminalMessage{Op: StdinOp, Data: "ls "})
+		websocket.JSON.Send(conn, TerminalMessage{Op: ResizeOp, Rows: 24, Cols: 80})
+		websocket.JSON.Send(conn, TerminalMessage{Op: StdinOp, Data: "-l\n"})
+		websocket.JSON.Send(conn, TerminalMessage{Op: "foo"})
+		for {
+			message := TerminalMessage{}
+			if err := websocket.JSON.Receive(conn, &message); err != nil {
+				return
+			}
+			output <- message
+		}
+	}()
+
+	expected := []TerminalMessage{
+		{Op: ErrorOp, Data: ResizeNotSupportedMessage},
+		{Op: StdoutOp, Data: "ls -l\n"},
+		{Op: StderrOp, Data: "done"},
+	}
+	actual := make([]TerminalMessage, 0)
+	for message := range output {
+		actual = append(actual, message)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Terminal session sent %#v, expected %#v", actual, expected)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"reflect"
 	"testing"
 
+	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 )
 
@@ -55,3 +56,41 @@ func TestGetLogs(t *testing.T) {
 		}
 	}
 }
+
+func TestGetLogOptions(t *testing.T) {
+	tailLines := int64(10)
+	pod := &api.Pod{
+		Spec: api.PodSpec{
+			Containers: []api.Container{{Name: "container-1"}, {Name: "container-2"}},
+		},
+	}
+
+	cases := []struct {
+		pod        *api.Pod
+		logOptions *api.PodLogOptions
+		expected   *api.PodLogOptions
+	}{
+		{
+			pod,
+			&api.PodLogOptions{TailLines: &tailLines},
+			&api.PodLogOptions{Container: "container-1", TailLines: &tailLines, Timestamps: true},
+		},
+		{
+			pod,
+			&api.PodLogOptions{Container: "container-2", Previous: true},
+			&api.PodLogOptions{Container: "container-2", Previous: true, Timestamps: true},
+		},
+		{
+			&api.Pod{},
+			&api.PodLogOptions{},
+			&api.PodLogOptions{Timestamps: true},
+		},
+	}
+	for _, c := range cases {
+		actual := getLogOptions(c.pod, c.logOptions)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getLogOptions(%#v, %#v) == %#v, expected %#v",
+				c.pod, c.logOptions, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+func TestGetPodsPerNode(t *testing.T) {
+	cases := []struct {
+		pods     []api.Pod
+		expected []NodePods
+	}{
+		{nil, []NodePods{}},
+		{
+			[]api.Pod{
+				{
+					ObjectMeta: api.ObjectMeta{Name: "pod-2"},
+					Spec:       api.PodSpec{NodeName: "node-b"},
+					Status:     api.PodStatus{Phase: api.PodRunning},
+				},
+				{
+					ObjectMeta: api.ObjectMeta{Name: "pod-1"},
+					Spec:       api.PodSpec{NodeName: "node-a"},
+					Status:     api.PodStatus{Phase: api.PodFailed},
+				},
+				{
+					ObjectMeta: api.ObjectMeta{Name: "pod-3"},
+					Spec:       api.PodSpec{NodeName: "node-b"},
+					Status:     api.PodStatus{Phase: api.PodPending},
+				},
+			},
+			[]NodePods{
+				{
+					NodeName: "node-a",
+					PodNames: []string{"pod-1"},
+					PodInfo: common.PodInfo{
+						Current:  1,
+						Desired:  1,
+						Failed:   1,
+						Warnings: []event.Event{},
+					},
+				},
+				{
+					NodeName: "node-b",
+					PodNames: []string{"pod-2", "pod-3"},
+					PodInfo: common.PodInfo{
+						Current:  2,
+						Desired:  1,
+						Running:  1,
+						Pending:  1,
+						Warnings: []event.Event{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := getPodsPerNode(c.pods)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getPodsPerNode(%#v) ==\n          %#v\nExpected: %#v", c.pods, actual,
+				c.expected)
+		}
+	}
+}
+
+func TestGetDaemonSetDetail(t *testing.T) {
+	daemonSet := &extensions.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: "ds-name", Namespace: "ds-namespace"},
+		Spec: extensions.DaemonSetSpec{
+			Selector: &unversioned.LabelSelector{
+				MatchLabels: map[string]string{"app": "agent"},
+			},
+			Template: api.PodTemplateSpec{
+				Spec: api.PodSpec{
+					NodeSelector: map[string]string{"role": "logging"},
+					Containers:   []api.Container{{Image: "agent:1"}},
+				},
+			},
+		},
+		Status: extensions.DaemonSetStatus{
+			DesiredNumberScheduled: 1,
+			CurrentNumberScheduled: 1,
+		},
+	}
+	pods := []api.Pod{{
+		ObjectMeta: api.ObjectMeta{Name: "agent-pod", Namespace: "ds-namespace"},
+		Spec:       api.PodSpec{NodeName: "node-a"},
+		Status:     api.PodStatus{Phase: api.PodRunning},
+	}}
+
+	actual := getDaemonSetDetail(daemonSet, pods, []api.Event{})
+
+	if !reflect.DeepEqual(actual.NodeSelector, map[string]string{"role": "logging"}) {
+		t.Errorf("Unexpected node selector %#v", actual.NodeSelector)
+	}
+	if !reflect.DeepEqual(actual.ContainerImages, []string{"agent:1"}) {
+		t.Errorf("Unexpected container images %#v", actual.ContainerImages)
+	}
+	expectedPodInfo := common.PodInfo{
+		Current:  1,
+		Desired:  1,
+		Running:  1,
+		Warnings: []event.Event{},
+	}
+	if !reflect.DeepEqual(actual.PodInfo, expectedPodInfo) {
+		t.Errorf("Unexpected pod info %#v, expected %#v", actual.PodInfo, expectedPodInfo)
+	}
+	if len(actual.PodsPerNode) != 1 || actual.PodsPerNode[0].NodeName != "node-a" {
+		t.Errorf("Unexpected pods per node %#v", actual.PodsPerNode)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+func TestGetDaemonSetListFromChannels(t *testing.T) {
+	cases := []struct {
+		k8sDaemonSets     extensions.DaemonSetList
+		k8sDaemonSetError error
+		pods              *api.PodList
+		expected          *DaemonSetList
+		expectedErr       error
+	}{
+		{
+			extensions.DaemonSetList{},
+			nil,
+			&api.PodList{},
+			&DaemonSetList{ListMeta: common.ListMeta{}, DaemonSets: []DaemonSet{}},
+			nil,
+		},
+		{
+			extensions.DaemonSetList{},
+			&k8serrors.StatusError{ErrStatus: unversioned.Status{Reason: "NotFound"}},
+			&api.PodList{},
+			&DaemonSetList{DaemonSets: []DaemonSet{}},
+			nil,
+		},
+		{
+			extensions.DaemonSetList{},
+			&k8serrors.StatusError{ErrStatus: unversioned.Status{Reason: "Forbidden"}},
+			&api.PodList{},
+			nil,
+			&k8serrors.StatusError{ErrStatus: unversioned.Status{Reason: "Forbidden"}},
+		},
+		{
+			extensions.DaemonSetList{
+				Items: []extensions.DaemonSet{{
+					ObjectMeta: api.ObjectMeta{Name: "ds-name", Namespace: "ds-namespace"},
+					Spec: extensions.DaemonSetSpec{
+						Selector: &unversioned.LabelSelector{
+							MatchLabels: map[string]string{"foo": "bar"},
+						},
+					},
+					Status: extensions.DaemonSetStatus{
+						DesiredNumberScheduled: 2,
+						CurrentNumberScheduled: 1,
+						NumberMisscheduled:     1,
+					},
+				}},
+			},
+			nil,
+			&api.PodList{
+				Items: []api.Pod{
+					{
+						ObjectMeta: api.ObjectMeta{
+							Namespace: "ds-namespace",
+							Labels:    map[string]string{"foo": "bar"},
+						},
+						Status: api.PodStatus{Phase: api.PodRunning},
+					},
+					{
+						ObjectMeta: api.ObjectMeta{
+							Namespace: "other-namespace",
+							Labels:    map[string]string{"foo": "bar"},
+						},
+						Status: api.PodStatus{Phase: api.PodRunning},
+					},
+				},
+			},
+			&DaemonSetList{
+				ListMeta: common.ListMeta{TotalItems: 1},
+				DaemonSets: []DaemonSet{{
+					ObjectMeta: common.ObjectMeta{
+						Name:      "ds-name",
+						Namespace: "ds-namespace",
+					},
+					Pods: common.PodInfo{
+						Current:  1,
+						Desired:  2,
+						Running:  1,
+						Warnings: []event.Event{},
+					},
+					DesiredNumberScheduled: 2,
+					CurrentNumberScheduled: 1,
+					NumberMisscheduled:     1,
+				}},
+			},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		channels := &common.ResourceChannels{
+			DaemonSetList: common.DaemonSetListChannel{
+				List:  make(chan *extensions.DaemonSetList, 1),
+				Error: make(chan error, 1),
+			},
+			PodList: common.PodListChannel{
+				List:  make(chan *api.PodList, 1),
+				Error: make(chan error, 1),
+			},
+			EventList: common.EventListChannel{
+				List:  make(chan *api.EventList, 1),
+				Error: make(chan error, 1),
+			},
+		}
+
+		channels.DaemonSetList.Error <- c.k8sDaemonSetError
+		channels.DaemonSetList.List <- &c.k8sDaemonSets
+
+		channels.PodList.List <- c.pods
+		channels.PodList.Error <- nil
+
+		channels.EventList.List <- &api.EventList{}
+		channels.EventList.Error <- nil
+
+		actual, err := GetDaemonSetListFromChannels(channels, common.NoDataSelect)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetDaemonSetListFromChannels() ==\n          %#v\nExpected: %#v", actual,
+				c.expected)
+		}
+		if !reflect.DeepEqual(err, c.expectedErr) {
+			t.Errorf("GetDaemonSetListFromChannels() returned error %#v, expected %#v", err,
+				c.expectedErr)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/replicaset"
+	resourceService "github.com/kubernetes/dashboard/resource/service"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	deploymentutil "k8s.io/kubernetes/pkg/util/deployment"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestGetDeploymentDetail(t *testing.T) {
+	deployment := &extensions.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "dp-1", Namespace: "ns-1"},
+		Spec: extensions.DeploymentSpec{
+			Selector: &unversioned.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Replicas: 4,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: map[string]string{"app": "foo"}},
+				Spec:       api.PodSpec{Containers: []api.Container{{Image: "foo:2"}}},
+			},
+			Strategy: extensions.DeploymentStrategy{
+				Type: extensions.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &extensions.RollingUpdateDeployment{
+					MaxSurge:       intstr.FromInt(1),
+					MaxUnavailable: intstr.FromString("25%"),
+				},
+			},
+			MinReadySeconds: 5,
+		},
+		Status: extensions.DeploymentStatus{
+			Replicas:            5,
+			UpdatedReplicas:     3,
+			AvailableReplicas:   4,
+			UnavailableReplicas: 1,
+		},
+	}
+
+	newReplicaSet := extensions.ReplicaSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:        "rs-3",
+			Namespace:   "ns-1",
+			Labels:      map[string]string{"app": "foo"},
+			Annotations: map[string]string{deploymentutil.RevisionAnnotation: "3"},
+		},
+		Spec: extensions.ReplicaSetSpec{
+			Replicas: 3,
+			Selector: &unversioned.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Template: deploymentutil.GetNewReplicaSetTemplate(deployment),
+		},
+		Status: extensions.ReplicaSetStatus{Replicas: 3},
+	}
+	oldReplicaSets := []extensions.ReplicaSet{{
+		ObjectMeta: api.ObjectMeta{
+			Name:        "rs-1",
+			Namespace:   "ns-1",
+			Labels:      map[string]string{"app": "foo"},
+			Annotations: map[string]string{deploymentutil.RevisionAnnotation: "1"},
+		},
+		Spec: extensions.ReplicaSetSpec{
+			Selector: &unversioned.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Template: api.PodTemplateSpec{
+				Spec: api.PodSpec{Containers: []api.Container{{Image: "foo:0"}}},
+			},
+		},
+	}, {
+		ObjectMeta: api.ObjectMeta{
+			Name:        "rs-2",
+			Namespace:   "ns-1",
+			Labels:      map[string]string{"app": "foo"},
+			Annotations: map[string]string{deploymentutil.RevisionAnnotation: "2"},
+		},
+		Spec: extensions.ReplicaSetSpec{
+			Replicas: 1,
+			Selector: &unversioned.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Template: api.PodTemplateSpec{
+				Spec: api.PodSpec{Containers: []api.Container{{Image: "foo:1"}}},
+			},
+		},
+		Status: extensions.ReplicaSetStatus{Replicas: 2},
+	}}
+	otherNamespaceReplicaSet := extensions.ReplicaSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "rs-4",
+			Namespace: "ns-2",
+			Labels:    map[string]string{"app": "foo"},
+		},
+	}
+
+	cases := []struct {
+		deployment  *extensions.Deployment
+		replicaSets []extensions.ReplicaSet
+		services    []api.Service
+		expected    *DeploymentDetail
+	}{
+		{
+			&extensions.Deployment{
+				ObjectMeta: api.ObjectMeta{Name: "dp-2", Namespace: "ns-1"},
+				Spec: extensions.DeploymentSpec{
+					Strategy: extensions.DeploymentStrategy{
+						Type: extensions.RecreateDeploymentStrategyType,
+					},
+				},
+			},
+			nil,
+			nil,
+			&DeploymentDetail{
+				ObjectMeta:     common.ObjectMeta{Name: "dp-2", Namespace: "ns-1"},
+				Strategy:       extensions.RecreateDeploymentStrategyType,
+				OldReplicaSets: []ReplicaSetRevision{},
+				ServiceList:    resourceService.ServiceList{Services: []resourceService.Service{}},
+				Events:         event.Events{Namespace: "ns-1", Events: []event.Event{}},
+			},
+		},
+		{
+			deployment,
+			[]extensions.ReplicaSet{oldReplicaSets[0], newReplicaSet, otherNamespaceReplicaSet,
+				oldReplicaSets[1]},
+			[]api.Service{{
+				ObjectMeta: api.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+				Spec:       api.ServiceSpec{Selector: map[string]string{"app": "foo"}},
+			}, {
+				ObjectMeta: api.ObjectMeta{Name: "svc-2", Namespace: "ns-1"},
+				Spec:       api.ServiceSpec{Selector: map[string]string{"app": "bar"}},
+			}},
+			&DeploymentDetail{
+				ObjectMeta: common.ObjectMeta{Name: "dp-1", Namespace: "ns-1"},
+				Selector:   deployment.Spec.Selector,
+				StatusInfo: DeploymentStatusInfo{
+					Replicas:    5,
+					Updated:     3,
+					Available:   4,
+					Unavailable: 1,
+				},
+				Strategy:        extensions.RollingUpdateDeploymentStrategyType,
+				MinReadySeconds: 5,
+				RollingUpdateStrategy: &RollingUpdateStrategy{
+					MaxSurge:       intstr.FromInt(1),
+					MaxUnavailable: intstr.FromString("25%"),
+				},
+				NewReplicaSet: &ReplicaSetRevision{
+					Revision: 3,
+					ReplicaSet: replicaset.ReplicaSet{
+						ObjectMeta: common.ObjectMeta{
+							Name:      "rs-3",
+							Namespace: "ns-1",
+							Labels:    map[string]string{"app": "foo"},
+						},
+						Pods: common.PodInfo{
+							Current:  3,
+							Desired:  3,
+							Warnings: []event.Event{},
+						},
+						ContainerImages: []string{"foo:2"},
+					},
+				},
+				OldReplicaSets: []ReplicaSetRevision{{
+					Revision: 2,
+					ReplicaSet: replicaset.ReplicaSet{
+						ObjectMeta: common.ObjectMeta{
+							Name:      "rs-2",
+							Namespace: "ns-1",
+							Labels:    map[string]string{"app": "foo"},
+						},
+						Pods: common.PodInfo{
+							Current:  2,
+							Desired:  1,
+							Warnings: []event.Event{},
+						},
+						ContainerImages: []string{"foo:1"},
+					},
+				}, {
+					Revision: 1,
+					ReplicaSet: replicaset.ReplicaSet{
+						ObjectMeta: common.ObjectMeta{
+							Name:      "rs-1",
+							Namespace: "ns-1",
+							Labels:    map[string]string{"app": "foo"},
+						},
+						Pods:            common.PodInfo{Warnings: []event.Event{}},
+						ContainerImages: []string{"foo:0"},
+					},
+				}},
+				ServiceList: resourceService.ServiceList{Services: []resourceService.Service{{
+					ObjectMeta:       common.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+					InternalEndpoint: common.Endpoint{Host: "svc-1.ns-1"},
+					Selector:         map[string]string{"app": "foo"},
+				}}},
+				Events: event.Events{Namespace: "ns-1", Events: []event.Event{}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := getDeploymentDetail(c.deployment, c.replicaSets, nil, c.services, nil)
+		if err != nil {
+			t.Errorf("getDeploymentDetail(%#v) returned error: %v", c.deployment, err)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getDeploymentDetail(%#v) == \n%#v\nexpected \n%#v\n",
+				c.deployment, actual, c.expected)
+		}
+	}
+}
+
+func TestDeleteDeployment(t *testing.T) {
+	deployment := &extensions.Deployment{
+		ObjectMeta: api.ObjectMeta{Labels: map[string]string{"app": "test"}},
+		Spec: extensions.DeploymentSpec{
+			Selector: &unversioned.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			},
+		},
+	}
+	labels := map[string]string{"app": "test"}
+	replicaSetList := &extensions.ReplicaSetList{
+		Items: []extensions.ReplicaSet{
+			{ObjectMeta: api.ObjectMeta{Name: "rs-1", Labels: labels}},
+			{ObjectMeta: api.ObjectMeta{Name: "rs-2", Labels: labels}},
+		},
+	}
+	podList := &api.PodList{
+		Items: []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "pod-1", Labels: labels}}},
+	}
+	serviceList := &api.ServiceList{
+		Items: []api.Service{{ObjectMeta: api.ObjectMeta{Name: "svc-1", Labels: labels}}},
+	}
+
+	cases := []struct {
+		deleteServices  bool
+		expectedActions []struct{ verb, resource string }
+	}{
+		{
+			false,
+			[]struct{ verb, resource string }{
+				{"get", "deployments"},
+				{"list", "replicasets"},
+				{"list", "pods"},
+				{"delete", "deployments"},
+				{"delete", "replicasets"},
+				{"delete", "replicasets"},
+				{"delete", "pods"},
+			},
+		},
+		{
+			true,
+			[]struct{ verb, resource string }{
+				{"get", "deployments"},
+				{"list", "deployments"},
+				{"list", "services"},
+				{"delete", "services"},
+				{"get", "deployments"},
+				{"list", "replicasets"},
+				{"list", "pods"},
+				{"delete", "deployments"},
+				{"delete", "replicasets"},
+				{"delete", "replicasets"},
+				{"delete", "pods"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake(deployment, replicaSetList, podList,
+			&extensions.DeploymentList{Items: []extensions.Deployment{*deployment}}, serviceList)
+
+		if err := DeleteDeployment(fakeClient, "test-namespace", "test-name",
+			c.deleteServices); err != nil {
+			t.Errorf("DeleteDeployment returned error: %v", err)
+		}
+
+		actions := fakeClient.Actions()
+		if len(actions) != len(c.expectedActions) {
+			t.Errorf("Unexpected actions: %v, expected %d actions got %d", actions,
+				len(c.expectedActions), len(actions))
+			continue
+		}
+
+		for i, expected := range c.expectedActions {
+			if actions[i].GetVerb() != expected.verb ||
+				actions[i].GetResource() != expected.resource {
+				t.Errorf("Unexpected action: %+v, expected %s-%s", actions[i], expected.verb,
+					expected.resource)
+			}
+		}
+	}
+}
+
+func TestUpdateReplicasCount(t *testing.T) {
+	cases := []struct {
+		namespace, deploymentName string
+		deploymentSpec            *DeploymentSpec
+		expected                  int
+		expectedActions           []string
+	}{
+		{
+			"default-ns", "deployment-1",
+			&DeploymentSpec{Replicas: 5},
+			5,
+			[]string{"get", "update"},
+		},
+	}
+
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake(&extensions.Deployment{})
+
+		UpdateReplicasCount(fakeClient, c.namespace, c.deploymentName, c.deploymentSpec)
+
+		actual := fakeClient.Actions()[1].(testclient.UpdateAction).GetObject().(*extensions.Deployment)
+		if actual.Spec.Replicas != c.expected {
+			t.Errorf("UpdateReplicasCount(client, %+v, %+v, %+v). Got %+v, expected %+v",
+				c.namespace, c.deploymentName, c.deploymentSpec, actual.Spec.Replicas, c.expected)
+		}
+
+		actions := fakeClient.Actions()
+		if len(actions) != len(c.expectedActions) {
+			t.Errorf("Unexpected actions: %v, expected %d actions got %d", actions,
+				len(c.expectedActions), len(actions))
+			continue
+		}
+
+		for i, verb := range c.expectedActions {
+			if actions[i].GetResource() != "deployments" {
+				t.Errorf("Unexpected action: %+v, expected %s-deployment",
+					actions[i], verb)
+			}
+			if actions[i].GetVerb() != verb {
+				t.Errorf("Unexpected action: %+v, expected %s-deployment",
+					actions[i], verb)
+			}
+		}
+	}
+}
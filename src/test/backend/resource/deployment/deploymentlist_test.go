@@ -40,7 +40,7 @@ func TestGetDeploymentListFromChannels(t *testing.T) {
 			extensions.DeploymentList{},
 			nil,
 			&api.PodList{},
-			&DeploymentList{[]Deployment{}},
+			&DeploymentList{ListMeta: common.ListMeta{}, Deployments: []Deployment{}},
 			nil,
 		},
 		{
@@ -116,6 +116,7 @@ func TestGetDeploymentListFromChannels(t *testing.T) {
 				},
 			},
 			&DeploymentList{
+				common.ListMeta{TotalItems: 1},
 				[]Deployment{{
 					ObjectMeta: common.ObjectMeta{
 						Name:              "rs-name",
@@ -174,7 +175,7 @@ func TestGetDeploymentListFromChannels(t *testing.T) {
 		channels.EventList.List <- &api.EventList{}
 		channels.EventList.Error <- nil
 
-		actual, err := GetDeploymentListFromChannels(channels)
+		actual, err := GetDeploymentListFromChannels(channels, common.NoDataSelect)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetDeploymentListFromChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
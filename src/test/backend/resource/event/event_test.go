@@ -17,8 +17,11 @@ package event
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
 )
 
 func TestGetPodsEventWarningsApi(t *testing.T) {
@@ -50,8 +53,9 @@ func TestGetPodsEventWarningsApi(t *testing.T) {
 			},
 			[]Event{
 				{
-					Message: "Test Message",
-					Type:    api.EventTypeWarning,
+					Message:    "Test Message",
+					ObjectName: "FailedPod",
+					Type:       api.EventTypeWarning,
 				},
 			},
 		},
@@ -211,6 +215,17 @@ func TestRemoveDuplicates(t *testing.T) {
 				{Reason: "test"},
 			},
 		},
+		{
+			[]api.Event{
+				{Reason: "test", Message: "msg"},
+				{Reason: "test", Message: "msg2"},
+				{Reason: "test", Message: "msg"},
+			},
+			[]api.Event{
+				{Reason: "test", Message: "msg"},
+				{Reason: "test", Message: "msg2"},
+			},
+		},
 		{
 			[]api.Event{
 				{Reason: "test"},
@@ -410,3 +425,163 @@ func TestFilterEventsByPodsUID(t *testing.T) {
 		}
 	}
 }
+
+func TestAppendEvents(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Berlin")
+
+	if err != nil {
+		t.Errorf("AppendEvents(...) cannot load location")
+	}
+
+	cases := []struct {
+		source   []api.Event
+		target   Events
+		expected Events
+	}{
+		{
+			nil, Events{}, Events{},
+		},
+		{
+			nil,
+			Events{
+				Namespace: "test-namespace",
+			},
+			Events{
+				Namespace: "test-namespace",
+			},
+		},
+		{
+			[]api.Event{
+				{
+					Message: "my-event-msg",
+					Source: api.EventSource{
+						Component: "my-event-src-component",
+						Host:      "my-event-src-host",
+					},
+					InvolvedObject: api.ObjectReference{
+						Kind:      "Pod",
+						Name:      "my-pod",
+						Namespace: "test-namespace",
+						FieldPath: "my-event-subobject",
+					},
+					Count: 7,
+					FirstTimestamp: unversioned.Time{
+						Time: time.Date(2015, 1, 1, 0, 0, 0, 0, location),
+					},
+					LastTimestamp: unversioned.Time{
+						Time: time.Date(2015, 1, 1, 0, 0, 0, 0, location),
+					},
+					Reason: "my-event-reason",
+					Type:   api.EventTypeNormal,
+					ObjectMeta: api.ObjectMeta{
+						Name:      "my-event",
+						Namespace: "test-namespace",
+					},
+				},
+			},
+			Events{
+				Namespace: "test-namespace",
+			},
+			Events{
+				Namespace: "test-namespace",
+				Events: []Event{
+					{
+						Message:         "my-event-msg",
+						ObjectKind:      "Pod",
+						ObjectName:      "my-pod",
+						ObjectNamespace: "test-namespace",
+						SourceComponent: "my-event-src-component",
+						SourceHost:      "my-event-src-host",
+						SubObject:       "my-event-subobject",
+						Count:           7,
+						FirstSeen: unversioned.Time{
+							Time: time.Date(2015, 1, 1, 0, 0, 0, 0,
+								location),
+						},
+						LastSeen: unversioned.Time{
+							Time: time.Date(2015, 1, 1, 0, 0, 0, 0,
+								location),
+						},
+						Reason: "my-event-reason",
+						Type:   api.EventTypeNormal,
+					},
+				},
+			},
+		},
+	}
+	for _, c := range cases {
+		actual := AppendEvents(c.source, c.target)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("AppendEvents(%#v, %#v) == %#v, expected %#v",
+				c.source, c.target, actual, c.expected)
+		}
+	}
+}
+
+func TestGetEventList(t *testing.T) {
+	since := unversioned.Unix(200, 0)
+	until := unversioned.Unix(400, 0)
+	events := []api.Event{
+		{Message: "old", Type: api.EventTypeWarning,
+			FirstTimestamp: unversioned.Unix(100, 0), LastTimestamp: unversioned.Unix(150, 0)},
+		{Message: "recent", Type: api.EventTypeWarning,
+			FirstTimestamp: unversioned.Unix(100, 0), LastTimestamp: unversioned.Unix(300, 0)},
+		{Message: "normal", Type: api.EventTypeNormal,
+			FirstTimestamp: unversioned.Unix(250, 0), LastTimestamp: unversioned.Unix(250, 0)},
+		{Message: "newest", Type: api.EventTypeWarning,
+			FirstTimestamp: unversioned.Unix(350, 0), LastTimestamp: unversioned.Unix(500, 0)},
+		{Message: "future", Type: api.EventTypeWarning,
+			FirstTimestamp: unversioned.Unix(450, 0), LastTimestamp: unversioned.Unix(450, 0)},
+	}
+
+	cases := []struct {
+		namespace        string
+		query            EventQuery
+		expectedMessages []string
+		expectedFields   string
+	}{
+		{
+			api.NamespaceAll,
+			EventQuery{},
+			[]string{"newest", "future", "recent", "normal", "old"},
+			"",
+		},
+		{
+			"test-namespace",
+			EventQuery{ObjectKind: "Pod", ObjectName: "my-pod", Reason: "Failed",
+				Type: api.EventTypeWarning, Since: &since, Until: &until},
+			[]string{"newest", "recent"},
+			"involvedObject.kind=Pod,involvedObject.name=my-pod,reason=Failed",
+		},
+	}
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake(&api.EventList{Items: events})
+
+		actual, err := GetEventList(fakeClient, c.namespace, c.query)
+		if err != nil {
+			t.Errorf("GetEventList(%#v) returned error %#v", c.query, err)
+			continue
+		}
+		if actual.Namespace != c.namespace {
+			t.Errorf("GetEventList(%#v) namespace == %s, expected %s", c.query, actual.Namespace,
+				c.namespace)
+		}
+		messages := make([]string, 0)
+		for _, event := range actual.Events {
+			messages = append(messages, event.Message)
+		}
+		if !reflect.DeepEqual(messages, c.expectedMessages) {
+			t.Errorf("GetEventList(%#v) returned events %#v, expected %#v", c.query, messages,
+				c.expectedMessages)
+		}
+
+		action := fakeClient.Actions()[0].(testclient.ListAction)
+		if action.GetNamespace() != c.namespace {
+			t.Errorf("Unexpected action namespace: %s, expected %s", action.GetNamespace(),
+				c.namespace)
+		}
+		if fields := action.GetListRestrictions().Fields.String(); fields != c.expectedFields {
+			t.Errorf("Unexpected field selector: %s, expected %s", fields, c.expectedFields)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/service"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestResolveBackend(t *testing.T) {
+	podRef := &api.ObjectReference{Kind: "Pod", Name: "web-pod"}
+	services := []api.Service{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "web"},
+			Spec: api.ServiceSpec{
+				Ports: []api.ServicePort{
+					{Name: "http", Port: 80},
+					{Name: "admin", Port: 8080},
+				},
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "idle"},
+			Spec: api.ServiceSpec{
+				Ports: []api.ServicePort{{Port: 80}},
+			},
+		},
+	}
+	endpoints := []api.Endpoints{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "web"},
+			Subsets: []api.EndpointSubset{{
+				Addresses:         []api.EndpointAddress{{IP: "10.1.0.1", TargetRef: podRef}},
+				NotReadyAddresses: []api.EndpointAddress{{IP: "10.1.0.2"}},
+				Ports: []api.EndpointPort{
+					{Name: "http", Port: 8000},
+					{Name: "admin", Port: 9000},
+				},
+			}},
+		},
+	}
+
+	cases := []struct {
+		backend  extensions.IngressBackend
+		expected IngressBackend
+	}{
+		{
+			extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(80)},
+			IngressBackend{
+				ServiceName: "web",
+				ServicePort: intstr.FromInt(80),
+				Status:      BackendOk,
+				Endpoints: []service.ServiceEndpoint{{
+					Host:      "10.1.0.1",
+					Ports:     []api.EndpointPort{{Name: "http", Port: 8000}},
+					Ready:     true,
+					TargetRef: podRef,
+				}},
+			},
+		},
+		{
+			extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromString("admin")},
+			IngressBackend{
+				ServiceName: "web",
+				ServicePort: intstr.FromString("admin"),
+				Status:      BackendOk,
+				Endpoints: []service.ServiceEndpoint{{
+					Host:      "10.1.0.1",
+					Ports:     []api.EndpointPort{{Name: "admin", Port: 9000}},
+					Ready:     true,
+					TargetRef: podRef,
+				}},
+			},
+		},
+		{
+			extensions.IngressBackend{ServiceName: "missing", ServicePort: intstr.FromInt(80)},
+			IngressBackend{
+				ServiceName: "missing",
+				ServicePort: intstr.FromInt(80),
+				Status:      BackendServiceNotFound,
+				Endpoints:   []service.ServiceEndpoint{},
+			},
+		},
+		{
+			extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(443)},
+			IngressBackend{
+				ServiceName: "web",
+				ServicePort: intstr.FromInt(443),
+				Status:      BackendPortNotFound,
+				Endpoints:   []service.ServiceEndpoint{},
+			},
+		},
+		{
+			extensions.IngressBackend{ServiceName: "idle", ServicePort: intstr.FromInt(80)},
+			IngressBackend{
+				ServiceName: "idle",
+				ServicePort: intstr.FromInt(80),
+				Status:      BackendNoReadyEndpoints,
+				Endpoints:   []service.ServiceEndpoint{},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := resolveBackend(c.backend, services, endpoints)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("resolveBackend(%#v) ==\n          %#v\nExpected: %#v", c.backend, actual,
+				c.expected)
+		}
+	}
+}
+
+func TestGetIngressDetail(t *testing.T) {
+	ingress := &extensions.Ingress{
+		ObjectMeta: api.ObjectMeta{Name: "ing", Namespace: "ns"},
+		Spec: extensions.IngressSpec{
+			Backend: &extensions.IngressBackend{
+				ServiceName: "default",
+				ServicePort: intstr.FromInt(80),
+			},
+			TLS: []extensions.IngressTLS{
+				{Hosts: []string{"foo.example.com"}, SecretName: "foo-tls"},
+			},
+			Rules: []extensions.IngressRule{
+				{Host: "bar.example.com"},
+				{
+					Host: "foo.example.com",
+					IngressRuleValue: extensions.IngressRuleValue{
+						HTTP: &extensions.HTTPIngressRuleValue{
+							Paths: []extensions.HTTPIngressPath{
+								{
+									Path: "/api",
+									Backend: extensions.IngressBackend{
+										ServiceName: "api",
+										ServicePort: intstr.FromInt(80),
+									},
+								},
+								{
+									Path: "/web",
+									Backend: extensions.IngressBackend{
+										ServiceName: "web",
+										ServicePort: intstr.FromInt(80),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual := getIngressDetail(ingress, []api.Service{}, []api.Endpoints{})
+
+	if actual.DefaultBackend == nil || actual.DefaultBackend.Status != BackendServiceNotFound {
+		t.Errorf("Unexpected default backend %#v", actual.DefaultBackend)
+	}
+	if !reflect.DeepEqual(actual.TLS, ingress.Spec.TLS) {
+		t.Errorf("Unexpected TLS %#v, expected %#v", actual.TLS, ingress.Spec.TLS)
+	}
+	if len(actual.Rules) != 3 {
+		t.Fatalf("Expected 3 rules, got %#v", actual.Rules)
+	}
+	if actual.Rules[0].Host != "bar.example.com" || actual.Rules[0].Backend != nil {
+		t.Errorf("Unexpected rule without paths %#v", actual.Rules[0])
+	}
+	for i, path := range []string{"/api", "/web"} {
+		rule := actual.Rules[i+1]
+		if rule.Host != "foo.example.com" || rule.Path != path || rule.Backend == nil ||
+			rule.Backend.Status != BackendServiceNotFound {
+			t.Errorf("Unexpected rule %#v for path %s", rule, path)
+		}
+	}
+}
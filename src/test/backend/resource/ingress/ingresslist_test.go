@@ -0,0 +1,97 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+func TestGetIngressListFromChannels(t *testing.T) {
+	cases := []struct {
+		k8sIngresses    extensions.IngressList
+		k8sIngressError error
+		expected        *IngressList
+		expectedErr     error
+	}{
+		{
+			extensions.IngressList{},
+			&k8serrors.StatusError{ErrStatus: unversioned.Status{Reason: "NotFound"}},
+			&IngressList{Ingresses: []Ingress{}},
+			nil,
+		},
+		{
+			extensions.IngressList{
+				Items: []extensions.Ingress{{
+					ObjectMeta: api.ObjectMeta{Name: "ing", Namespace: "ns"},
+					Spec: extensions.IngressSpec{
+						Rules: []extensions.IngressRule{
+							{Host: "foo.example.com"},
+							{Host: ""},
+							{Host: "bar.example.com"},
+							{Host: "foo.example.com"},
+						},
+					},
+					Status: extensions.IngressStatus{
+						LoadBalancer: api.LoadBalancerStatus{
+							Ingress: []api.LoadBalancerIngress{
+								{IP: "10.0.0.1"},
+								{Hostname: "lb.example.com"},
+							},
+						},
+					},
+				}},
+			},
+			nil,
+			&IngressList{
+				ListMeta: common.ListMeta{TotalItems: 1},
+				Ingresses: []Ingress{{
+					ObjectMeta: common.ObjectMeta{Name: "ing", Namespace: "ns"},
+					Hosts:      []string{"foo.example.com", "bar.example.com"},
+					Addresses:  []string{"10.0.0.1", "lb.example.com"},
+				}},
+			},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		channels := &common.ResourceChannels{
+			IngressList: common.IngressListChannel{
+				List:  make(chan *extensions.IngressList, 1),
+				Error: make(chan error, 1),
+			},
+		}
+
+		channels.IngressList.List <- &c.k8sIngresses
+		channels.IngressList.Error <- c.k8sIngressError
+
+		actual, err := GetIngressListFromChannels(channels, common.NoDataSelect)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetIngressListFromChannels() ==\n          %#v\nExpected: %#v", actual,
+				c.expected)
+		}
+		if !reflect.DeepEqual(err, c.expectedErr) {
+			t.Errorf("GetIngressListFromChannels() returned error %#v, expected %#v", err,
+				c.expectedErr)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	cases := []struct {
+		conditions []extensions.JobCondition
+		expected   JobStatus
+	}{
+		{nil, JobRunning},
+		{
+			[]extensions.JobCondition{
+				{Type: extensions.JobComplete, Status: api.ConditionTrue},
+			},
+			JobComplete,
+		},
+		{
+			[]extensions.JobCondition{
+				{Type: extensions.JobComplete, Status: api.ConditionFalse},
+				{Type: extensions.JobFailed, Status: api.ConditionTrue},
+			},
+			JobFailed,
+		},
+	}
+
+	for _, c := range cases {
+		job := extensions.Job{Status: extensions.JobStatus{Conditions: c.conditions}}
+		actual := getJobStatus(job)
+		if actual != c.expected {
+			t.Errorf("getJobStatus(%#v) == %#v, expected %#v", job, actual, c.expected)
+		}
+	}
+}
+
+func TestDeleteJob(t *testing.T) {
+	fakeClient := testclient.NewSimpleFake(
+		&extensions.Job{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Spec: extensions.JobSpec{
+				Selector: &unversioned.LabelSelector{
+					MatchLabels: map[string]string{"app": "foo"},
+				},
+			},
+		},
+		&api.PodList{Items: []api.Pod{{
+			ObjectMeta: api.ObjectMeta{
+				Name:      "foo-pod",
+				Namespace: "bar",
+				Labels:    map[string]string{"app": "foo"},
+			},
+		}}},
+	)
+
+	DeleteJob(fakeClient, "bar", "foo")
+
+	expectedActions := []struct{ verb, resource string }{
+		{"get", "jobs"},
+		{"list", "pods"},
+		{"delete", "jobs"},
+		{"delete", "pods"},
+	}
+	actions := fakeClient.Actions()
+	if len(actions) != len(expectedActions) {
+		t.Fatalf("Unexpected actions: %v, expected %d actions got %d", actions,
+			len(expectedActions), len(actions))
+	}
+	for i, expected := range expectedActions {
+		if actions[i].GetVerb() != expected.verb || actions[i].GetResource() != expected.resource {
+			t.Errorf("Unexpected action: %+v, expected %s %s", actions[i], expected.verb,
+				expected.resource)
+		}
+	}
+}
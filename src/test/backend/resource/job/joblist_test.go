@@ -0,0 +1,141 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+func TestGetJobListFromChannels(t *testing.T) {
+	completions := 5
+	cases := []struct {
+		k8sJobs     extensions.JobList
+		k8sJobError error
+		pods        *api.PodList
+		expected    *JobList
+		expectedErr error
+	}{
+		{
+			extensions.JobList{},
+			nil,
+			&api.PodList{},
+			&JobList{ListMeta: common.ListMeta{}, Jobs: []Job{}},
+			nil,
+		},
+		{
+			extensions.JobList{},
+			&k8serrors.StatusError{ErrStatus: unversioned.Status{Reason: "NotFound"}},
+			&api.PodList{},
+			&JobList{Jobs: []Job{}},
+			nil,
+		},
+		{
+			extensions.JobList{
+				Items: []extensions.Job{{
+					ObjectMeta: api.ObjectMeta{Name: "job-name", Namespace: "job-namespace"},
+					Spec: extensions.JobSpec{
+						Completions: &completions,
+						Selector: &unversioned.LabelSelector{
+							MatchLabels: map[string]string{"foo": "bar"},
+						},
+					},
+					Status: extensions.JobStatus{Active: 1, Succeeded: 2, Failed: 1},
+				}},
+			},
+			nil,
+			&api.PodList{
+				Items: []api.Pod{
+					{
+						ObjectMeta: api.ObjectMeta{
+							Namespace: "job-namespace",
+							Labels:    map[string]string{"foo": "bar"},
+						},
+						Status: api.PodStatus{Phase: api.PodRunning},
+					},
+					{
+						ObjectMeta: api.ObjectMeta{
+							Namespace: "job-namespace",
+							Labels:    map[string]string{"foo": "baz"},
+						},
+						Status: api.PodStatus{Phase: api.PodRunning},
+					},
+				},
+			},
+			&JobList{
+				ListMeta: common.ListMeta{TotalItems: 1},
+				Jobs: []Job{{
+					ObjectMeta: common.ObjectMeta{
+						Name:      "job-name",
+						Namespace: "job-namespace",
+					},
+					Pods: common.PodInfo{
+						Current:  1,
+						Desired:  1,
+						Running:  1,
+						Warnings: []event.Event{},
+					},
+					Completions: &completions,
+					Active:      1,
+					Succeeded:   2,
+					Failed:      1,
+				}},
+			},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		channels := &common.ResourceChannels{
+			JobList: common.JobListChannel{
+				List:  make(chan *extensions.JobList, 1),
+				Error: make(chan error, 1),
+			},
+			PodList: common.PodListChannel{
+				List:  make(chan *api.PodList, 1),
+				Error: make(chan error, 1),
+			},
+			EventList: common.EventListChannel{
+				List:  make(chan *api.EventList, 1),
+				Error: make(chan error, 1),
+			},
+		}
+
+		channels.JobList.Error <- c.k8sJobError
+		channels.JobList.List <- &c.k8sJobs
+
+		channels.PodList.List <- c.pods
+		channels.PodList.Error <- nil
+
+		channels.EventList.List <- &api.EventList{}
+		channels.EventList.Error <- nil
+
+		actual, err := GetJobListFromChannels(channels, common.NoDataSelect)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetJobListFromChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
+		}
+		if !reflect.DeepEqual(err, c.expectedErr) {
+			t.Errorf("GetJobListFromChannels() returned error %#v, expected %#v", err,
+				c.expectedErr)
+		}
+	}
+}
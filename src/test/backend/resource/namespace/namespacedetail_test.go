@@ -0,0 +1,105 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func TestGetNamespaceDetail(t *testing.T) {
+	namespace := &api.Namespace{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Labels: map[string]string{"team": "bar"}},
+		Status:     api.NamespaceStatus{Phase: api.NamespaceActive},
+	}
+	pods := &api.PodList{Items: []api.Pod{{}, {}}}
+	secrets := &api.SecretList{Items: []api.Secret{{}}}
+	quotas := &api.ResourceQuotaList{Items: []api.ResourceQuota{{
+		ObjectMeta: api.ObjectMeta{Name: "quota"},
+		Status: api.ResourceQuotaStatus{
+			Hard: api.ResourceList{
+				api.ResourcePods: resource.MustParse("10"),
+				api.ResourceCPU:  resource.MustParse("2"),
+			},
+			Used: api.ResourceList{
+				api.ResourcePods: resource.MustParse("2"),
+			},
+		},
+	}}}
+	limits := []api.LimitRangeItem{{
+		Type: api.LimitTypeContainer,
+		Max:  api.ResourceList{api.ResourceMemory: resource.MustParse("1Gi")},
+	}}
+	limitRanges := &api.LimitRangeList{Items: []api.LimitRange{{
+		ObjectMeta: api.ObjectMeta{Name: "limits"},
+		Spec:       api.LimitRangeSpec{Limits: limits},
+	}}}
+
+	jobs := &extensions.JobList{Items: []extensions.Job{{}}}
+	daemonSets := &extensions.DaemonSetList{Items: []extensions.DaemonSet{{}}}
+	ingresses := &extensions.IngressList{Items: []extensions.Ingress{{}}}
+	claims := &api.PersistentVolumeClaimList{Items: []api.PersistentVolumeClaim{{}, {}}}
+
+	fakeClient := testclient.NewSimpleFake(namespace, pods, secrets, quotas, limitRanges, jobs,
+		daemonSets, ingresses, claims, &api.ReplicationControllerList{}, &api.ServiceList{},
+		&api.EventList{})
+
+	actual, err := GetNamespaceDetail(fakeClient, "foo")
+	if err != nil {
+		t.Fatalf("GetNamespaceDetail(client, foo) returned error %v", err)
+	}
+
+	expected := &NamespaceDetail{
+		ObjectMeta: common.ObjectMeta{Name: "foo", Labels: map[string]string{"team": "bar"}},
+		Phase:      api.NamespaceActive,
+		ResourceQuotas: []ResourceQuota{{
+			ObjectMeta: common.ObjectMeta{Name: "quota"},
+			Resources: []ResourceQuotaStatus{
+				{Name: api.ResourceCPU, Used: "0", Hard: "2"},
+				{Name: api.ResourcePods, Used: "2", Hard: "10"},
+			},
+		}},
+		LimitRanges: []LimitRange{{
+			ObjectMeta: common.ObjectMeta{Name: "limits"},
+			Limits:     limits,
+		}},
+		ObjectCounts: ObjectCounts{Pods: 2, Jobs: 1, DaemonSets: 1, Ingresses: 1,
+			PersistentVolumeClaims: 2, Secrets: 1},
+		Events: event.Events{Namespace: "foo", Events: []event.Event{}},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetNamespaceDetail(client, foo) == \ngot %#v, \nexpected %#v", actual, expected)
+	}
+}
+
+func TestDeleteNamespace(t *testing.T) {
+	fakeClient := testclient.NewSimpleFake(&api.Namespace{ObjectMeta: api.ObjectMeta{Name: "foo"}})
+
+	DeleteNamespace(fakeClient, "foo")
+
+	actions := fakeClient.Actions()
+	if len(actions) != 1 || actions[0].GetVerb() != "delete" ||
+		actions[0].(testclient.DeleteAction).GetName() != "foo" {
+		t.Errorf("Unexpected actions: %v, expected delete of foo namespace", actions)
+	}
+}
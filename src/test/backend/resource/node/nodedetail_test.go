@@ -0,0 +1,109 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+func TestGetNodeAllocatedResources(t *testing.T) {
+	cases := []struct {
+		node     api.Node
+		pods     []api.Pod
+		expected NodeAllocatedResources
+	}{
+		{
+			api.Node{},
+			nil,
+			NodeAllocatedResources{},
+		},
+		{
+			api.Node{
+				Status: api.NodeStatus{
+					Capacity: api.ResourceList{
+						api.ResourceCPU:    resource.MustParse("4"),
+						api.ResourceMemory: resource.MustParse("1000"),
+					},
+					Allocatable: api.ResourceList{
+						api.ResourceCPU:    resource.MustParse("2"),
+						api.ResourceMemory: resource.MustParse("500"),
+					},
+				},
+			},
+			[]api.Pod{
+				{
+					Spec: api.PodSpec{
+						Containers: []api.Container{
+							{
+								Resources: api.ResourceRequirements{
+									Requests: api.ResourceList{
+										api.ResourceCPU:    resource.MustParse("500m"),
+										api.ResourceMemory: resource.MustParse("100"),
+									},
+									Limits: api.ResourceList{
+										api.ResourceCPU:    resource.MustParse("1"),
+										api.ResourceMemory: resource.MustParse("250"),
+									},
+								},
+							},
+							{
+								Resources: api.ResourceRequirements{
+									Requests: api.ResourceList{
+										api.ResourceCPU: resource.MustParse("500m"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			NodeAllocatedResources{
+				CPURequests:            1000,
+				CPURequestsFraction:    50,
+				CPULimits:              1000,
+				CPULimitsFraction:      50,
+				CPUAllocatable:         2000,
+				MemoryRequests:         100,
+				MemoryRequestsFraction: 20,
+				MemoryLimits:           250,
+				MemoryLimitsFraction:   50,
+				MemoryAllocatable:      500,
+			},
+		},
+		{
+			api.Node{
+				Status: api.NodeStatus{
+					Capacity: api.ResourceList{
+						api.ResourceCPU: resource.MustParse("4"),
+					},
+				},
+			},
+			nil,
+			NodeAllocatedResources{CPUAllocatable: 4000},
+		},
+	}
+
+	for _, c := range cases {
+		actual := getNodeAllocatedResources(c.node, c.pods)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getNodeAllocatedResources(%#v, %#v) == \n%#v\nexpected \n%#v\n",
+				c.node, c.pods, actual, c.expected)
+		}
+	}
+}
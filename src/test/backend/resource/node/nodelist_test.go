@@ -0,0 +1,127 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+
+	"github.com/kubernetes/dashboard/resource/common"
+)
+
+func TestGetNodeListFromChannels(t *testing.T) {
+	readyCondition := api.NodeCondition{Type: api.NodeReady, Status: api.ConditionTrue}
+	cases := []struct {
+		nodes         *api.NodeList
+		nodesError    error
+		pods          *api.PodList
+		expected      *NodeList
+		expectedError error
+	}{
+		{
+			&api.NodeList{},
+			nil,
+			&api.PodList{},
+			&NodeList{ListMeta: common.ListMeta{}, Nodes: []Node{}},
+			nil,
+		},
+		{
+			&api.NodeList{},
+			errors.New("MyCustomError"),
+			&api.PodList{},
+			nil,
+			errors.New("MyCustomError"),
+		},
+		{
+			&api.NodeList{
+				Items: []api.Node{
+					{
+						ObjectMeta: api.ObjectMeta{Name: "node-1"},
+						Spec:       api.NodeSpec{Unschedulable: true},
+						Status: api.NodeStatus{
+							Conditions: []api.NodeCondition{readyCondition},
+						},
+					},
+					{ObjectMeta: api.ObjectMeta{Name: "node-2"}},
+				},
+			},
+			nil,
+			&api.PodList{
+				Items: []api.Pod{
+					{
+						Spec:   api.PodSpec{NodeName: "node-1"},
+						Status: api.PodStatus{Phase: api.PodRunning},
+					},
+					{
+						Spec:   api.PodSpec{NodeName: "node-1"},
+						Status: api.PodStatus{Phase: api.PodSucceeded},
+					},
+					{
+						Spec:   api.PodSpec{NodeName: "node-2"},
+						Status: api.PodStatus{Phase: api.PodPending},
+					},
+				},
+			},
+			&NodeList{
+				ListMeta: common.ListMeta{TotalItems: 2},
+				Nodes: []Node{
+					{
+						ObjectMeta:    common.ObjectMeta{Name: "node-1"},
+						Ready:         api.ConditionTrue,
+						Unschedulable: true,
+						Conditions:    []api.NodeCondition{readyCondition},
+						PodCount:      1,
+					},
+					{
+						ObjectMeta: common.ObjectMeta{Name: "node-2"},
+						Ready:      api.ConditionUnknown,
+						PodCount:   1,
+					},
+				},
+			},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		channels := &common.ResourceChannels{
+			NodeList: common.NodeListChannel{
+				List:  make(chan *api.NodeList, 1),
+				Error: make(chan error, 1),
+			},
+			PodList: common.PodListChannel{
+				List:  make(chan *api.PodList, 1),
+				Error: make(chan error, 1),
+			},
+		}
+
+		channels.NodeList.Error <- c.nodesError
+		channels.NodeList.List <- c.nodes
+
+		channels.PodList.List <- c.pods
+		channels.PodList.Error <- nil
+
+		actual, err := GetNodeListFromChannels(channels, common.NoDataSelect)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetNodeListFromChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
+		}
+		if !reflect.DeepEqual(err, c.expectedError) {
+			t.Errorf("GetNodeListFromChannels() ==\n          %#v\nExpected: %#v", err, c.expectedError)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+func TestWatch(t *testing.T) {
+	selector, _ := labels.Parse("app=foo")
+	fakeWatcher := watch.NewFake()
+	fakeClient := testclient.NewSimpleFake()
+	fakeClient.PrependWatchReactor("pods", testclient.DefaultWatchReactor(fakeWatcher, nil))
+
+	watcher, err := Watch(fakeClient, WatchSpec{
+		Resource:        "pods",
+		Namespace:       "foo-namespace",
+		LabelSelector:   selector,
+		ResourceVersion: "7",
+	})
+	if err != nil {
+		t.Fatalf("Watch() returned error %#v", err)
+	}
+
+	actions := fakeClient.Actions()
+	if len(actions) != 1 || actions[0].GetVerb() != "watch" ||
+		actions[0].GetResource() != "pods" || actions[0].GetNamespace() != "foo-namespace" {
+		t.Fatalf("Unexpected actions: %#v", actions)
+	}
+	restrictions := actions[0].(testclient.WatchAction).GetWatchRestrictions()
+	if restrictions.Labels.String() != "app=foo" || restrictions.ResourceVersion != "7" {
+		t.Errorf("Unexpected watch restrictions: %#v", restrictions)
+	}
+
+	go fakeWatcher.Modify(&api.Pod{ObjectMeta: api.ObjectMeta{
+		Name:            "foo-pod",
+		Namespace:       "foo-namespace",
+		ResourceVersion: "8",
+	}})
+	expected := Notification{
+		Type: watch.Modified,
+		ObjectMeta: common.ObjectMeta{
+			Name:      "foo-pod",
+			Namespace: "foo-namespace",
+		},
+		TypeMeta:        common.TypeMeta{Kind: "Pod"},
+		ResourceVersion: "8",
+	}
+	if actual := <-watcher.Notifications(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Watch() notified %#v, expected %#v", actual, expected)
+	}
+
+	watcher.Stop()
+	if _, ok := <-watcher.Notifications(); ok {
+		t.Error("Notifications channel is not closed after the watch is stopped")
+	}
+	if err := watcher.Err(); err != nil {
+		t.Errorf("Watch() ended with error %#v, expected none", err)
+	}
+}
+
+func TestWatchUnknownResource(t *testing.T) {
+	if CanWatch("foos") {
+		t.Error("CanWatch(\"foos\") == true, expected false")
+	}
+	if _, err := Watch(testclient.NewSimpleFake(), WatchSpec{Resource: "foos"}); err == nil {
+		t.Error("Watch() of unknown resource returned no error")
+	}
+}
+
+func TestToNotification(t *testing.T) {
+	cases := []struct {
+		event         watch.Event
+		expected      *Notification
+		expectedError bool
+	}{
+		{
+			watch.Event{Type: watch.Deleted, Object: &api.Node{ObjectMeta: api.ObjectMeta{
+				Name:            "foo-node",
+				ResourceVersion: "3",
+			}}},
+			&Notification{
+				Type:            watch.Deleted,
+				ObjectMeta:      common.ObjectMeta{Name: "foo-node"},
+				TypeMeta:        common.TypeMeta{Kind: "Node"},
+				ResourceVersion: "3",
+			},
+			false,
+		},
+		{
+			watch.Event{Type: watch.Error, Object: &unversioned.Status{
+				Code:    http.StatusGone,
+				Reason:  unversioned.StatusReasonExpired,
+				Message: "too old resource version",
+			}},
+			nil,
+			true,
+		},
+		{watch.Event{Type: watch.Added, Object: &unversioned.Status{}}, nil, true},
+	}
+	for _, c := range cases {
+		actual, err := toNotification(c.event, "Node")
+		if (err != nil) != c.expectedError {
+			t.Errorf("toNotification(%#v) returned error %#v, expected error: %t", c.event, err,
+				c.expectedError)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("toNotification(%#v) == %#v, expected %#v", c.event, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolume
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+func TestGetPersistentVolumeListFromChannels(t *testing.T) {
+	capacity := api.ResourceList{api.ResourceStorage: resource.MustParse("10Gi")}
+	cases := []struct {
+		k8sPersistentVolumes api.PersistentVolumeList
+		expected             *PersistentVolumeList
+	}{
+		{
+			api.PersistentVolumeList{},
+			&PersistentVolumeList{PersistentVolumes: []PersistentVolume{}},
+		},
+		{
+			api.PersistentVolumeList{
+				Items: []api.PersistentVolume{
+					{
+						ObjectMeta: api.ObjectMeta{Name: "pv-bound"},
+						Spec: api.PersistentVolumeSpec{
+							Capacity:                      capacity,
+							AccessModes:                   []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+							PersistentVolumeReclaimPolicy: api.PersistentVolumeReclaimRetain,
+							PersistentVolumeSource: api.PersistentVolumeSource{
+								NFS: &api.NFSVolumeSource{Server: "nfs", Path: "/exports"},
+							},
+							ClaimRef: &api.ObjectReference{Namespace: "ns", Name: "claim"},
+						},
+						Status: api.PersistentVolumeStatus{Phase: api.VolumeBound},
+					},
+					{
+						ObjectMeta: api.ObjectMeta{Name: "pv-available"},
+						Status:     api.PersistentVolumeStatus{Phase: api.VolumeAvailable},
+					},
+				},
+			},
+			&PersistentVolumeList{
+				ListMeta: common.ListMeta{TotalItems: 2},
+				PersistentVolumes: []PersistentVolume{
+					{
+						ObjectMeta:    common.ObjectMeta{Name: "pv-bound"},
+						Capacity:      capacity,
+						AccessModes:   []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+						ReclaimPolicy: api.PersistentVolumeReclaimRetain,
+						Status:        api.VolumeBound,
+						Claim:         &ClaimReference{Namespace: "ns", Name: "claim"},
+						StorageSource: NFSSource,
+					},
+					{
+						ObjectMeta:    common.ObjectMeta{Name: "pv-available"},
+						Status:        api.VolumeAvailable,
+						StorageSource: UnknownSource,
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		channels := &common.ResourceChannels{
+			PersistentVolumeList: common.PersistentVolumeListChannel{
+				List:  make(chan *api.PersistentVolumeList, 1),
+				Error: make(chan error, 1),
+			},
+		}
+
+		channels.PersistentVolumeList.List <- &c.k8sPersistentVolumes
+		channels.PersistentVolumeList.Error <- nil
+
+		actual, err := GetPersistentVolumeListFromChannels(channels, common.NoDataSelect)
+		if err != nil {
+			t.Errorf("GetPersistentVolumeListFromChannels() returned error %#v", err)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetPersistentVolumeListFromChannels() ==\n          %#v\nExpected: %#v",
+				actual, c.expected)
+		}
+	}
+}
+
+func TestGetStorageSourceType(t *testing.T) {
+	cases := []struct {
+		source   api.PersistentVolumeSource
+		expected StorageSourceType
+	}{
+		{api.PersistentVolumeSource{}, UnknownSource},
+		{
+			api.PersistentVolumeSource{GCEPersistentDisk: &api.GCEPersistentDiskVolumeSource{}},
+			GCEPersistentDiskSource,
+		},
+		{
+			api.PersistentVolumeSource{HostPath: &api.HostPathVolumeSource{Path: "/tmp"}},
+			HostPathSource,
+		},
+		{
+			api.PersistentVolumeSource{AWSElasticBlockStore: &api.AWSElasticBlockStoreVolumeSource{}},
+			AWSElasticBlockStoreSource,
+		},
+	}
+
+	for _, c := range cases {
+		actual := GetStorageSourceType(c.source)
+		if actual != c.expected {
+			t.Errorf("GetStorageSourceType(%#v) == %s, expected %s", c.source, actual,
+				c.expected)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func claimVolume(claimName string) api.Volume {
+	return api.Volume{
+		Name: "data",
+		VolumeSource: api.VolumeSource{
+			PersistentVolumeClaim: &api.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+		},
+	}
+}
+
+func TestGetClaimPods(t *testing.T) {
+	pods := []api.Pod{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "mounts-claim"},
+			Spec: api.PodSpec{
+				Volumes: []api.Volume{
+					{Name: "tmp", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+					claimVolume("claim"),
+				},
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "mounts-other-claim"},
+			Spec:       api.PodSpec{Volumes: []api.Volume{claimVolume("other")}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "no-volumes"},
+		},
+	}
+
+	actual := getClaimPods("claim", pods)
+	if len(actual) != 1 || actual[0].Name != "mounts-claim" {
+		t.Errorf("getClaimPods() == %#v, expected only mounts-claim pod", actual)
+	}
+
+	actual = getClaimPods("missing", pods)
+	if !reflect.DeepEqual(actual, []api.Pod{}) {
+		t.Errorf("getClaimPods() == %#v, expected no pods", actual)
+	}
+}
+
+func TestGetPersistentVolumeClaimDetail(t *testing.T) {
+	fakeClient := testclient.NewSimpleFake(
+		&api.PersistentVolumeClaim{
+			ObjectMeta: api.ObjectMeta{Name: "claim", Namespace: "ns"},
+			Spec: api.PersistentVolumeClaimSpec{
+				AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			},
+			Status: api.PersistentVolumeClaimStatus{Phase: api.ClaimPending},
+		},
+		&api.PodList{},
+	)
+
+	actual, err := GetPersistentVolumeClaimDetail(fakeClient, nil, "ns", "claim")
+	if err != nil {
+		t.Fatalf("GetPersistentVolumeClaimDetail() returned error %#v", err)
+	}
+	if actual.Status != api.ClaimPending || actual.Volume != "" {
+		t.Errorf("Unexpected claim status %s and volume %s", actual.Status, actual.Volume)
+	}
+	if !reflect.DeepEqual(actual.RequestedAccessModes,
+		[]api.PersistentVolumeAccessMode{api.ReadWriteOnce}) {
+		t.Errorf("Unexpected requested access modes %#v", actual.RequestedAccessModes)
+	}
+	if len(actual.Pods.Pods) != 0 {
+		t.Errorf("Expected no pods, got %#v", actual.Pods.Pods)
+	}
+}
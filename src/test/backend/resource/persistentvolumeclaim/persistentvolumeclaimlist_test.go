@@ -0,0 +1,78 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package persistentvolumeclaim
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+func TestGetPersistentVolumeClaimListFromChannels(t *testing.T) {
+	capacity := api.ResourceList{api.ResourceStorage: resource.MustParse("1Gi")}
+	channels := &common.ResourceChannels{
+		PersistentVolumeClaimList: common.PersistentVolumeClaimListChannel{
+			List:  make(chan *api.PersistentVolumeClaimList, 1),
+			Error: make(chan error, 1),
+		},
+	}
+	channels.PersistentVolumeClaimList.List <- &api.PersistentVolumeClaimList{
+		Items: []api.PersistentVolumeClaim{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "bound", Namespace: "ns"},
+				Spec:       api.PersistentVolumeClaimSpec{VolumeName: "pv"},
+				Status: api.PersistentVolumeClaimStatus{
+					Phase:       api.ClaimBound,
+					Capacity:    capacity,
+					AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+				},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "pending", Namespace: "ns"},
+				Status:     api.PersistentVolumeClaimStatus{Phase: api.ClaimPending},
+			},
+		},
+	}
+	channels.PersistentVolumeClaimList.Error <- nil
+
+	expected := &PersistentVolumeClaimList{
+		ListMeta: common.ListMeta{TotalItems: 2},
+		PersistentVolumeClaims: []PersistentVolumeClaim{
+			{
+				ObjectMeta:  common.ObjectMeta{Name: "bound", Namespace: "ns"},
+				Status:      api.ClaimBound,
+				Volume:      "pv",
+				Capacity:    capacity,
+				AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			},
+			{
+				ObjectMeta: common.ObjectMeta{Name: "pending", Namespace: "ns"},
+				Status:     api.ClaimPending,
+			},
+		},
+	}
+
+	actual, err := GetPersistentVolumeClaimListFromChannels(channels, common.NoDataSelect)
+	if err != nil {
+		t.Errorf("GetPersistentVolumeClaimListFromChannels() returned error %#v", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetPersistentVolumeClaimListFromChannels() ==\n          %#v\nExpected: %#v",
+			actual, expected)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+)
+
+func TestGetPodQOSClass(t *testing.T) {
+	guaranteed := api.ResourceList{
+		api.ResourceCPU:    resource.MustParse("100m"),
+		api.ResourceMemory: resource.MustParse("100Mi"),
+	}
+	cases := []struct {
+		pod      *api.Pod
+		expected string
+	}{
+		{&api.Pod{}, "BestEffort"},
+		{
+			&api.Pod{Spec: api.PodSpec{Containers: []api.Container{{}}}},
+			"BestEffort",
+		},
+		{
+			&api.Pod{Spec: api.PodSpec{Containers: []api.Container{
+				{Resources: api.ResourceRequirements{Requests: guaranteed, Limits: guaranteed}},
+			}}},
+			"Guaranteed",
+		},
+		{
+			&api.Pod{Spec: api.PodSpec{Containers: []api.Container{
+				{Resources: api.ResourceRequirements{Requests: guaranteed, Limits: guaranteed}},
+				{},
+			}}},
+			"Burstable",
+		},
+		{
+			&api.Pod{Spec: api.PodSpec{Containers: []api.Container{
+				{Resources: api.ResourceRequirements{Requests: guaranteed}},
+			}}},
+			"Burstable",
+		},
+	}
+
+	for _, c := range cases {
+		actual := getPodQOSClass(c.pod)
+		if actual != c.expected {
+			t.Errorf("getPodQOSClass(%#v) == %s, expected %s", c.pod, actual, c.expected)
+		}
+	}
+}
+
+func TestGetPodController(t *testing.T) {
+	cases := []struct {
+		annotations map[string]string
+		expected    *api.ObjectReference
+	}{
+		{nil, nil},
+		{map[string]string{"kubernetes.io/created-by": "not-a-json"}, nil},
+		{
+			map[string]string{"kubernetes.io/created-by": `{"kind":"SerializedReference",` +
+				`"apiVersion":"v1","reference":{"kind":"ReplicationController",` +
+				`"namespace":"foo","name":"my-rc"}}`},
+			&api.ObjectReference{Kind: "ReplicationController", Namespace: "foo", Name: "my-rc"},
+		},
+	}
+
+	for _, c := range cases {
+		pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "my-pod", Annotations: c.annotations}}
+		actual := getPodController(pod)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getPodController(%#v) == %#v, expected %#v", pod, actual, c.expected)
+		}
+	}
+}
+
+func TestToPodDetail(t *testing.T) {
+	terminated := api.ContainerState{
+		Terminated: &api.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+	}
+	waiting := api.ContainerState{
+		Waiting: &api.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+	}
+	cases := []struct {
+		pod      *api.Pod
+		events   []api.Event
+		expected *PodDetail
+	}{
+		{
+			&api.Pod{
+				ObjectMeta: api.ObjectMeta{Name: "my-pod", Namespace: "foo"},
+				Spec: api.PodSpec{
+					NodeName:   "my-node",
+					Containers: []api.Container{{Name: "app", Image: "my-image"}, {Name: "sidecar"}},
+				},
+				Status: api.PodStatus{
+					Phase: api.PodRunning,
+					ContainerStatuses: []api.ContainerStatus{
+						{
+							Name:                 "app",
+							ImageID:              "docker://my-image-id",
+							State:                waiting,
+							LastTerminationState: terminated,
+							RestartCount:         3,
+						},
+					},
+				},
+			},
+			[]api.Event{{Reason: "BackOff", Type: api.EventTypeWarning}},
+			&PodDetail{
+				ObjectMeta:   common.ObjectMeta{Name: "my-pod", Namespace: "foo"},
+				PodPhase:     api.PodRunning,
+				NodeName:     "my-node",
+				RestartCount: 3,
+				QOSClass:     "BestEffort",
+				Containers: []Container{
+					{
+						Name:                 "app",
+						Image:                "my-image",
+						ImageID:              "docker://my-image-id",
+						State:                waiting,
+						LastTerminationState: terminated,
+						RestartCount:         3,
+					},
+					{Name: "sidecar"},
+				},
+				Events: event.Events{
+					Namespace: "foo",
+					Events:    []event.Event{{Reason: "BackOff", Type: api.EventTypeWarning}},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := toPodDetail(c.pod, c.events)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("toPodDetail(%#v, %#v) == \n%#v\nexpected \n%#v\n",
+				c.pod, c.events, actual, c.expected)
+		}
+	}
+}
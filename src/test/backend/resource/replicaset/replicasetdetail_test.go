@@ -0,0 +1,296 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicaset
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/event"
+	resourceService "github.com/kubernetes/dashboard/resource/service"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func TestGetReplicaSetDetail(t *testing.T) {
+	replicaSet := &extensions.ReplicaSet{
+		ObjectMeta: api.ObjectMeta{Name: "rs-1", Namespace: "ns-1"},
+		Spec: extensions.ReplicaSetSpec{
+			Replicas: 2,
+			Selector: &unversioned.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Template: api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: map[string]string{"app": "foo"}},
+				Spec:       api.PodSpec{Containers: []api.Container{{Image: "foo:1"}}},
+			},
+		},
+		Status: extensions.ReplicaSetStatus{Replicas: 1},
+	}
+
+	cases := []struct {
+		pods     []api.Pod
+		services []api.Service
+		nodes    []api.Node
+		events   []api.Event
+		expected *ReplicaSetDetail
+	}{
+		{
+			nil,
+			nil,
+			nil,
+			nil,
+			&ReplicaSetDetail{
+				ObjectMeta:      common.ObjectMeta{Name: "rs-1", Namespace: "ns-1"},
+				Selector:        replicaSet.Spec.Selector,
+				ContainerImages: []string{"foo:1"},
+				PodInfo:         common.PodInfo{Current: 1, Desired: 2, Warnings: []event.Event{}},
+				ServiceList:     resourceService.ServiceList{Services: []resourceService.Service{}},
+				Events:          event.Events{Namespace: "ns-1", Events: []event.Event{}},
+			},
+		},
+		{
+			[]api.Pod{{
+				ObjectMeta: api.ObjectMeta{Name: "pod-1", Namespace: "ns-1"},
+				Spec:       api.PodSpec{NodeName: "node-1"},
+				Status:     api.PodStatus{Phase: api.PodRunning},
+			}},
+			[]api.Service{{
+				ObjectMeta: api.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+				Spec: api.ServiceSpec{
+					Type:     api.ServiceTypeNodePort,
+					Selector: map[string]string{"app": "foo"},
+					Ports:    []api.ServicePort{{Protocol: "TCP", NodePort: 30100}},
+				},
+			}, {
+				ObjectMeta: api.ObjectMeta{Name: "svc-2", Namespace: "ns-2"},
+				Spec:       api.ServiceSpec{Selector: map[string]string{"app": "foo"}},
+			}},
+			[]api.Node{{
+				ObjectMeta: api.ObjectMeta{Name: "node-1"},
+				Status: api.NodeStatus{
+					Addresses: []api.NodeAddress{{
+						Type:    api.NodeExternalIP,
+						Address: "192.168.1.108",
+					}},
+				},
+			}},
+			[]api.Event{{
+				ObjectMeta: api.ObjectMeta{Name: "ev-1", Namespace: "ns-1"},
+				Message:    "Created pod: pod-1",
+				Reason:     "SuccessfulCreate",
+			}},
+			&ReplicaSetDetail{
+				ObjectMeta:      common.ObjectMeta{Name: "rs-1", Namespace: "ns-1"},
+				Selector:        replicaSet.Spec.Selector,
+				ContainerImages: []string{"foo:1"},
+				PodInfo: common.PodInfo{
+					Current:  1,
+					Desired:  2,
+					Running:  1,
+					Warnings: []event.Event{},
+				},
+				ServiceList: resourceService.ServiceList{Services: []resourceService.Service{{
+					ObjectMeta: common.ObjectMeta{Name: "svc-1", Namespace: "ns-1"},
+					InternalEndpoint: common.Endpoint{
+						Host:  "svc-1.ns-1",
+						Ports: []common.ServicePort{{Port: 0, Protocol: "TCP"}},
+					},
+					ExternalEndpoints: []common.Endpoint{{
+						Host:  "192.168.1.108",
+						Ports: []common.ServicePort{{Port: 30100, Protocol: "TCP"}},
+					}},
+					Selector: map[string]string{"app": "foo"},
+				}}},
+				Events: event.Events{
+					Namespace: "ns-1",
+					Events: []event.Event{{
+						Message: "Created pod: pod-1",
+						Reason:  "SuccessfulCreate",
+						Type:    api.EventTypeNormal,
+					}},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := getReplicaSetDetail(replicaSet, c.pods, c.services, c.nodes, c.events)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("getReplicaSetDetail(%#v, %#v, %#v, %#v, %#v) == \n%#v\nexpected \n%#v\n",
+				replicaSet, c.pods, c.services, c.nodes, c.events, actual, c.expected)
+		}
+	}
+}
+
+func TestDeleteReplicaSetServices(t *testing.T) {
+	cases := []struct {
+		namespace, name string
+		replicaSet      *extensions.ReplicaSet
+		replicaSetList  *extensions.ReplicaSetList
+		serviceList     *api.ServiceList
+		expectedActions []string
+	}{
+		{
+			"test-namespace", "test-name",
+			&extensions.ReplicaSet{
+				Spec: extensions.ReplicaSetSpec{
+					Selector: &unversioned.LabelSelector{
+						MatchLabels: map[string]string{"app": "test"},
+					},
+				},
+			},
+			&extensions.ReplicaSetList{
+				Items: []extensions.ReplicaSet{{}},
+			},
+			&api.ServiceList{
+				Items: []api.Service{
+					{Spec: api.ServiceSpec{Selector: map[string]string{"app": "test"}}},
+				},
+			},
+			[]string{"get", "list", "list", "delete"},
+		},
+		{
+			"test-namespace", "test-name",
+			&extensions.ReplicaSet{
+				Spec: extensions.ReplicaSetSpec{
+					Selector: &unversioned.LabelSelector{
+						MatchLabels: map[string]string{"app": "test"},
+					},
+				},
+			},
+			&extensions.ReplicaSetList{
+				Items: []extensions.ReplicaSet{{}, {}},
+			},
+			&api.ServiceList{
+				Items: []api.Service{
+					{Spec: api.ServiceSpec{Selector: map[string]string{"app": "test"}}},
+				},
+			},
+			[]string{"get", "list"},
+		},
+	}
+
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake(c.replicaSet, c.replicaSetList, c.serviceList)
+
+		DeleteReplicaSetServices(fakeClient, c.namespace, c.name)
+
+		actions := fakeClient.Actions()
+		if len(actions) != len(c.expectedActions) {
+			t.Errorf("Unexpected actions: %v, expected %d actions got %d", actions,
+				len(c.expectedActions), len(actions))
+			continue
+		}
+
+		for i, verb := range c.expectedActions {
+			if actions[i].GetVerb() != verb {
+				t.Errorf("Unexpected action: %+v, expected %s",
+					actions[i], verb)
+			}
+		}
+	}
+}
+
+func TestDeleteReplicaSet(t *testing.T) {
+	replicaSet := &extensions.ReplicaSet{
+		Spec: extensions.ReplicaSetSpec{
+			Selector: &unversioned.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			},
+		},
+	}
+	podList := &api.PodList{
+		Items: []api.Pod{{
+			ObjectMeta: api.ObjectMeta{
+				Name:   "pod-1",
+				Labels: map[string]string{"app": "test"},
+			},
+		}},
+	}
+
+	fakeClient := testclient.NewSimpleFake(replicaSet, podList)
+
+	if err := DeleteReplicaSet(fakeClient, "test-namespace", "test-name", false); err != nil {
+		t.Errorf("DeleteReplicaSet returned error: %v", err)
+	}
+
+	expectedActions := []struct{ verb, resource string }{
+		{"get", "replicasets"},
+		{"list", "pods"},
+		{"delete", "replicasets"},
+		{"delete", "pods"},
+	}
+	actions := fakeClient.Actions()
+	if len(actions) != len(expectedActions) {
+		t.Fatalf("Unexpected actions: %v, expected %d actions got %d", actions,
+			len(expectedActions), len(actions))
+	}
+
+	for i, expected := range expectedActions {
+		if actions[i].GetVerb() != expected.verb || actions[i].GetResource() != expected.resource {
+			t.Errorf("Unexpected action: %+v, expected %s-%s", actions[i], expected.verb,
+				expected.resource)
+		}
+	}
+}
+
+func TestUpdateReplicasCount(t *testing.T) {
+	cases := []struct {
+		namespace, replicaSetName string
+		replicaSetSpec            *ReplicaSetSpec
+		expected                  int
+		expectedActions           []string
+	}{
+		{
+			"default-ns", "replicaSet-1",
+			&ReplicaSetSpec{Replicas: 5},
+			5,
+			[]string{"get", "update"},
+		},
+	}
+
+	for _, c := range cases {
+		replicaSet := &extensions.ReplicaSet{}
+		fakeClient := testclient.NewSimpleFake(replicaSet)
+
+		UpdateReplicasCount(fakeClient, c.namespace, c.replicaSetName, c.replicaSetSpec)
+
+		actual := fakeClient.Actions()[1].(testclient.UpdateAction).GetObject().(*extensions.ReplicaSet)
+		if actual.Spec.Replicas != c.expected {
+			t.Errorf("UpdateReplicasCount(client, %+v, %+v, %+v). Got %+v, expected %+v",
+				c.namespace, c.replicaSetName, c.replicaSetSpec, actual.Spec.Replicas, c.expected)
+		}
+
+		actions := fakeClient.Actions()
+		if len(actions) != len(c.expectedActions) {
+			t.Errorf("Unexpected actions: %v, expected %d actions got %d", actions,
+				len(c.expectedActions), len(actions))
+			continue
+		}
+
+		for i, verb := range c.expectedActions {
+			if actions[i].GetResource() != "replicasets" {
+				t.Errorf("Unexpected action: %+v, expected %s-replicaSet",
+					actions[i], verb)
+			}
+			if actions[i].GetVerb() != verb {
+				t.Errorf("Unexpected action: %+v, expected %s-replicaSet",
+					actions[i], verb)
+			}
+		}
+	}
+}
@@ -40,7 +40,7 @@ func TestGetReplicaSetListFromChannels(t *testing.T) {
 			extensions.ReplicaSetList{},
 			nil,
 			&api.PodList{},
-			&ReplicaSetList{[]ReplicaSet{}},
+			&ReplicaSetList{ListMeta: common.ListMeta{}, ReplicaSets: []ReplicaSet{}},
 			nil,
 		},
 		{
@@ -116,6 +116,7 @@ func TestGetReplicaSetListFromChannels(t *testing.T) {
 				},
 			},
 			&ReplicaSetList{
+				common.ListMeta{TotalItems: 1},
 				[]ReplicaSet{{
 					ObjectMeta: common.ObjectMeta{
 						Name:              "rs-name",
@@ -174,7 +175,7 @@ func TestGetReplicaSetListFromChannels(t *testing.T) {
 		channels.EventList.List <- &api.EventList{}
 		channels.EventList.Error <- nil
 
-		actual, err := GetReplicaSetListFromChannels(channels)
+		actual, err := GetReplicaSetListFromChannels(channels, common.NoDataSelect)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("GetReplicaSetListChannels() ==\n          %#v\nExpected: %#v", actual, c.expected)
 		}
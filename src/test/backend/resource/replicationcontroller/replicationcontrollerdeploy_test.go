@@ -19,15 +19,21 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/kubernetes/dashboard/resource/deployment"
 	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	kubectlResource "k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/intstr"
 )
 
 func TestDeployApp(t *testing.T) {
 	namespace := "foo-namespace"
 	spec := &AppDeploymentSpec{
+		Kind:            ReplicationControllerKind,
 		Namespace:       namespace,
 		Name:            "foo-name",
 		RunAsPrivileged: true,
@@ -81,10 +87,170 @@ func TestDeployApp(t *testing.T) {
 	}
 }
 
+func TestDeployAppAsDeployment(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		Replicas:  3,
+		Labels:    []Label{{Key: "app", Value: "foo"}},
+		RollingUpdateStrategy: &deployment.RollingUpdateStrategy{
+			MaxSurge:       intstr.FromInt(2),
+			MaxUnavailable: intstr.FromString("25%"),
+		},
+	}
+	testClient := testclient.NewSimpleFake()
+	testClient.Resources = map[string]*unversioned.APIResourceList{
+		"extensions/v1beta1": {
+			APIResources: []unversioned.APIResource{{Name: "deployments"}},
+		},
+	}
+
+	DeployApp(spec, testClient)
+
+	actions := testClient.Actions()
+	if len(actions) != 2 {
+		t.Fatalf("Expected discovery and create actions but got %#v", actions)
+	}
+
+	createAction := actions[1].(testclient.CreateActionImpl)
+	deployment := createAction.GetObject().(*extensions.Deployment)
+	if deployment.Spec.Replicas != 3 {
+		t.Errorf("Expected replicas to be %#v but got %#v", 3, deployment.Spec.Replicas)
+	}
+	expectedSelector := &unversioned.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}
+	if !reflect.DeepEqual(deployment.Spec.Selector, expectedSelector) {
+		t.Errorf("Expected selector to be %#v but got %#v", expectedSelector,
+			deployment.Spec.Selector)
+	}
+	expectedStrategy := extensions.DeploymentStrategy{
+		Type: extensions.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &extensions.RollingUpdateDeployment{
+			MaxSurge:       intstr.FromInt(2),
+			MaxUnavailable: intstr.FromString("25%"),
+		},
+	}
+	if !reflect.DeepEqual(deployment.Spec.Strategy, expectedStrategy) {
+		t.Errorf("Expected strategy to be %#v but got %#v", expectedStrategy,
+			deployment.Spec.Strategy)
+	}
+}
+
+func TestDeployAppDefaultKind(t *testing.T) {
+	cases := []struct {
+		resources map[string]*unversioned.APIResourceList
+		expected  string
+	}{
+		{nil, "replicationcontrollers"},
+		{
+			map[string]*unversioned.APIResourceList{
+				"extensions/v1beta1": {
+					APIResources: []unversioned.APIResource{{Name: "jobs"}},
+				},
+			},
+			"replicationcontrollers",
+		},
+		{
+			map[string]*unversioned.APIResourceList{
+				"extensions/v1beta1": {
+					APIResources: []unversioned.APIResource{{Name: "deployments"}},
+				},
+			},
+			"deployments",
+		},
+	}
+
+	for _, c := range cases {
+		spec := &AppDeploymentSpec{Namespace: "foo-namespace", Name: "foo-name"}
+		testClient := testclient.NewSimpleFake()
+		testClient.Resources = c.resources
+
+		DeployApp(spec, testClient)
+
+		actions := testClient.Actions()
+		if len(actions) != 2 || actions[1].GetResource() != c.expected {
+			t.Errorf("Expected %s to be created but got actions %#v", c.expected, actions)
+		}
+	}
+}
+
+func TestDeployAppAsDaemonSet(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Kind:      DaemonSetKind,
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		Labels:    []Label{{Key: "app", Value: "foo"}},
+	}
+	testClient := testclient.NewSimpleFake()
+
+	DeployApp(spec, testClient)
+
+	createAction := testClient.Actions()[0].(testclient.CreateActionImpl)
+	daemonSet := createAction.GetObject().(*extensions.DaemonSet)
+	expectedSelector := &unversioned.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}
+	if !reflect.DeepEqual(daemonSet.Spec.Selector, expectedSelector) {
+		t.Errorf("Expected selector to be %#v but got %#v", expectedSelector,
+			daemonSet.Spec.Selector)
+	}
+	if daemonSet.Spec.Template.Spec.Containers[0].Name != "foo-name" {
+		t.Errorf("Expected container to be named %#v but got %#v", "foo-name",
+			daemonSet.Spec.Template.Spec.Containers[0].Name)
+	}
+}
+
+func TestDeployAppAsJob(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Kind:      JobKind,
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		Replicas:  3,
+		Labels:    []Label{{Key: "app", Value: "foo"}},
+	}
+	testClient := testclient.NewSimpleFake()
+
+	DeployApp(spec, testClient)
+
+	createAction := testClient.Actions()[0].(testclient.CreateActionImpl)
+	job := createAction.GetObject().(*extensions.Job)
+	if job.Spec.Completions == nil || *job.Spec.Completions != 3 {
+		t.Errorf("Expected 3 completions but got %#v", job.Spec.Completions)
+	}
+	if job.Spec.Parallelism == nil || *job.Spec.Parallelism != 3 {
+		t.Errorf("Expected parallelism of 3 but got %#v", job.Spec.Parallelism)
+	}
+	if job.Spec.Template.Spec.RestartPolicy != api.RestartPolicyOnFailure {
+		t.Errorf("Expected restart policy to be %#v but got %#v", api.RestartPolicyOnFailure,
+			job.Spec.Template.Spec.RestartPolicy)
+	}
+	if job.Spec.Template.Spec.Containers[0].Name != "foo-name" {
+		t.Errorf("Expected container to be named %#v but got %#v", "foo-name",
+			job.Spec.Template.Spec.Containers[0].Name)
+	}
+}
+
+func TestDeployAppWithUnsupportedKind(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Kind:      "foo-kind",
+		Namespace: "foo-namespace",
+		Name:      "foo-name",
+		Replicas:  3,
+	}
+	testClient := testclient.NewSimpleFake()
+
+	_, err := DeployApp(spec, testClient)
+
+	if !k8serrors.IsBadRequest(err) {
+		t.Errorf("Expected bad request error but got %#v", err)
+	}
+	if len(testClient.Actions()) != 0 {
+		t.Errorf("Expected no objects to be created but got %#v", testClient.Actions())
+	}
+}
+
 func TestDeployAppContainerCommands(t *testing.T) {
 	command := "foo-command"
 	commandArgs := "foo-command-args"
 	spec := &AppDeploymentSpec{
+		Kind:                 ReplicationControllerKind,
 		Namespace:            "foo-namespace",
 		Name:                 "foo-name",
 		ContainerCommand:     &command,
@@ -111,6 +277,7 @@ func TestDeployAppContainerCommands(t *testing.T) {
 
 func TestDeployShouldPopulateEnvVars(t *testing.T) {
 	spec := &AppDeploymentSpec{
+		Kind:      ReplicationControllerKind,
 		Namespace: "foo-namespace",
 		Name:      "foo-name",
 		Variables: []EnvironmentVariable{{"foo", "bar"}},
@@ -146,6 +313,7 @@ func TestDeployWithResourceRequirements(t *testing.T) {
 	cpuRequirement := resource.Quantity{}
 	memoryRequirement := resource.Quantity{}
 	spec := &AppDeploymentSpec{
+		Kind:              ReplicationControllerKind,
 		Namespace:         "foo-namespace",
 		Name:              "foo-name",
 		CpuRequirement:    &cpuRequirement,
@@ -191,14 +359,22 @@ func TestDeployAppFromFileWithValidContent(t *testing.T) {
 		Name:    "foo-name",
 		Content: validContent,
 	}
-	fakeCreateObjectFromInfo := func(info *kubectlResource.Info) (bool, error) { return true, nil }
+	fakeDeployObjectFromInfo := func(info *kubectlResource.Info, mode AppDeploymentFromFileMode,
+		dryRun bool) (ObjectDeploymentStatus, error) {
+		return ObjectCreated, nil
+	}
+	expected := []ObjectDeploymentResult{{
+		Kind:   "Namespace",
+		Name:   "test-deployfile-namespace",
+		Status: ObjectCreated,
+	}}
 
-	isDeployed, err := DeployAppFromFile(spec, fakeCreateObjectFromInfo, nil)
+	results, err := DeployAppFromFile(spec, fakeDeployObjectFromInfo, nil)
 	if err != nil {
 		t.Errorf("Expected return value to have %#v but got %#v", nil, err)
 	}
-	if !isDeployed {
-		t.Errorf("Expected return value to have %#v but got %#v", true, isDeployed)
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("Expected return value to have %#v but got %#v", expected, results)
 	}
 }
 
@@ -207,14 +383,62 @@ func TestDeployAppFromFileWithInvalidContent(t *testing.T) {
 		Name:    "foo-name",
 		Content: "foo-content-invalid",
 	}
-	// return is set to true to check if the validation prior to this function really works
-	fakeCreateObjectFromInfo := func(info *kubectlResource.Info) (bool, error) { return true, nil }
+	// return is set to created to check if the validation prior to this function really works
+	fakeDeployObjectFromInfo := func(info *kubectlResource.Info, mode AppDeploymentFromFileMode,
+		dryRun bool) (ObjectDeploymentStatus, error) {
+		return ObjectCreated, nil
+	}
 
-	isDeployed, err := DeployAppFromFile(spec, fakeCreateObjectFromInfo, nil)
+	results, err := DeployAppFromFile(spec, fakeDeployObjectFromInfo, nil)
 	if err == nil {
 		t.Errorf("Expected return value to have an error but got %#v", nil)
 	}
-	if isDeployed {
-		t.Errorf("Expected return value to have %#v but got %#v", false, isDeployed)
+	if len(results) != 0 {
+		t.Errorf("Expected no objects to be deployed but got %#v", results)
+	}
+}
+
+func TestDeployAppFromFileWithUnsupportedMode(t *testing.T) {
+	spec := &AppDeploymentFromFileSpec{
+		Name:    "foo-name",
+		Content: "foo-content",
+		Mode:    "foo-mode",
+	}
+	fakeDeployObjectFromInfo := func(info *kubectlResource.Info, mode AppDeploymentFromFileMode,
+		dryRun bool) (ObjectDeploymentStatus, error) {
+		return ObjectCreated, nil
+	}
+
+	results, err := DeployAppFromFile(spec, fakeDeployObjectFromInfo, nil)
+	if err == nil {
+		t.Errorf("Expected return value to have an error but got %#v", nil)
+	}
+	if len(results) != 0 {
+		t.Errorf("Expected no objects to be deployed but got %#v", results)
+	}
+}
+
+func TestCopyServerFields(t *testing.T) {
+	liveObject := &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "foo-name", ResourceVersion: "42"},
+		Spec:       api.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	object := &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "foo-name"},
+		Spec:       api.ServiceSpec{Selector: map[string]string{"app": "foo"}},
+	}
+	expected := &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "foo-name", ResourceVersion: "42"},
+		Spec: api.ServiceSpec{
+			Selector:  map[string]string{"app": "foo"},
+			ClusterIP: "10.0.0.1",
+		},
+	}
+
+	if err := copyServerFields(liveObject, object); err != nil {
+		t.Fatalf("copyServerFields() returned unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(object, expected) {
+		t.Errorf("copyServerFields() produced %#v, expected %#v", object, expected)
 	}
 }
@@ -0,0 +1,196 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicationcontroller
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func TestDeployAppRollback(t *testing.T) {
+	createdDeployment := DeployedObject{
+		Kind:      "Deployment",
+		Name:      "foo-name",
+		Namespace: "foo-namespace",
+	}
+	createdService := DeployedObject{
+		Kind:      "Service",
+		Name:      "foo-name",
+		Namespace: "foo-namespace",
+	}
+	succeed := func(action testclient.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	}
+	fail := func(action testclient.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("foo-error")
+	}
+
+	cases := []struct {
+		reactors []struct {
+			verb, resource string
+			reaction       testclient.ReactionFunc
+		}
+		expected      *AppDeploymentReport
+		expectedError error
+	}{
+		{
+			[]struct {
+				verb, resource string
+				reaction       testclient.ReactionFunc
+			}{
+				{"create", "deployments", succeed},
+				{"create", "services", succeed},
+			},
+			&AppDeploymentReport{
+				Created:        []DeployedObject{createdDeployment, createdService},
+				RolledBack:     []DeployedObject{},
+				RollbackFailed: []DeployedObject{},
+			},
+			nil,
+		},
+		{
+			[]struct {
+				verb, resource string
+				reaction       testclient.ReactionFunc
+			}{
+				{"create", "deployments", fail},
+			},
+			&AppDeploymentReport{
+				Created:        []DeployedObject{},
+				RolledBack:     []DeployedObject{},
+				RollbackFailed: []DeployedObject{},
+				Error:          "foo-error",
+			},
+			errors.New("foo-error"),
+		},
+		{
+			[]struct {
+				verb, resource string
+				reaction       testclient.ReactionFunc
+			}{
+				{"create", "deployments", succeed},
+				{"create", "services", fail},
+				{"delete", "deployments", succeed},
+			},
+			&AppDeploymentReport{
+				Created:        []DeployedObject{createdDeployment},
+				RolledBack:     []DeployedObject{createdDeployment},
+				RollbackFailed: []DeployedObject{},
+				Error:          "foo-error",
+			},
+			errors.New("foo-error"),
+		},
+		{
+			[]struct {
+				verb, resource string
+				reaction       testclient.ReactionFunc
+			}{
+				{"create", "deployments", succeed},
+				{"create", "services", fail},
+				{"delete", "deployments", fail},
+			},
+			&AppDeploymentReport{
+				Created:    []DeployedObject{createdDeployment},
+				RolledBack: []DeployedObject{},
+				RollbackFailed: []DeployedObject{{
+					Kind:      "Deployment",
+					Name:      "foo-name",
+					Namespace: "foo-namespace",
+					Error:     "foo-error",
+				}},
+				Error: "foo-error",
+			},
+			errors.New("foo-error"),
+		},
+	}
+
+	for _, c := range cases {
+		spec := &AppDeploymentSpec{
+			Kind:         DeploymentKind,
+			Namespace:    "foo-namespace",
+			Name:         "foo-name",
+			Labels:       []Label{{Key: "app", Value: "foo"}},
+			PortMappings: []PortMapping{{Port: 80, TargetPort: 8080, Protocol: api.ProtocolTCP}},
+		}
+		testClient := testclient.NewSimpleFake()
+		for _, reactor := range c.reactors {
+			testClient.PrependReactor(reactor.verb, reactor.resource, reactor.reaction)
+		}
+
+		actual, err := DeployApp(spec, testClient)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("DeployApp(%#v) == \n%#v\nexpected \n%#v\n", spec, actual, c.expected)
+		}
+		if !reflect.DeepEqual(err, c.expectedError) {
+			t.Errorf("DeployApp(%#v) returned error %#v, expected %#v", spec, err,
+				c.expectedError)
+		}
+	}
+}
+
+func TestDeployAppRollbackDeletesPods(t *testing.T) {
+	spec := &AppDeploymentSpec{
+		Kind:         DaemonSetKind,
+		Namespace:    "foo-namespace",
+		Name:         "foo-name",
+		Labels:       []Label{{Key: "app", Value: "foo"}},
+		PortMappings: []PortMapping{{Port: 80, TargetPort: 8080, Protocol: api.ProtocolTCP}},
+	}
+	testClient := testclient.NewSimpleFake(&api.PodList{
+		Items: []api.Pod{{
+			ObjectMeta: api.ObjectMeta{
+				Name:   "foo-pod",
+				Labels: map[string]string{"app": "foo"},
+			},
+		}},
+	})
+	for _, verb := range []string{"create", "delete"} {
+		testClient.PrependReactor(verb, "daemonsets",
+			func(action testclient.Action) (bool, runtime.Object, error) {
+				return true, nil, nil
+			})
+	}
+	testClient.PrependReactor("create", "services",
+		func(action testclient.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("foo-error")
+		})
+
+	DeployApp(spec, testClient)
+
+	expectedActions := []struct{ verb, resource string }{
+		{"create", "daemonsets"},
+		{"create", "services"},
+		{"delete", "daemonsets"},
+		{"list", "pods"},
+		{"delete", "pods"},
+	}
+	actions := testClient.Actions()
+	if len(actions) != len(expectedActions) {
+		t.Fatalf("Unexpected actions: %v, expected %d actions got %d", actions,
+			len(expectedActions), len(actions))
+	}
+
+	for i, expected := range expectedActions {
+		if actions[i].GetVerb() != expected.verb || actions[i].GetResource() != expected.resource {
+			t.Errorf("Unexpected action: %+v, expected %s-%s", actions[i], expected.verb,
+				expected.resource)
+		}
+	}
+}
@@ -186,6 +186,7 @@ func TestGetReplicationControllerList(t *testing.T) {
 			},
 			},
 			&ReplicationControllerList{
+				ListMeta: common.ListMeta{TotalItems: 2},
 				ReplicationControllers: []ReplicationController{
 					{
 						ObjectMeta: common.ObjectMeta{
@@ -217,7 +218,7 @@ func TestGetReplicationControllerList(t *testing.T) {
 	}
 	for _, c := range cases {
 		actual := getReplicationControllerList(c.replicationControllers, c.services, c.pods,
-			events, c.nodes)
+			events, c.nodes, common.NoDataSelect)
 		if !reflect.DeepEqual(actual, c.expected) {
 			t.Errorf("getReplicationControllerList(%#v, %#v) == \n%#v\nexpected \n%#v\n",
 				c.replicationControllers, c.services, actual, c.expected)
@@ -0,0 +1,187 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func TestCreateSecret(t *testing.T) {
+	cases := []struct {
+		spec            SecretSpec
+		expectedActions []string
+		expectedBadReq  bool
+	}{
+		{
+			&GenericSecretSpec{Name: "foo", Namespace: "bar",
+				Data: map[string][]byte{"key": []byte("value")}},
+			[]string{"create"}, false,
+		},
+		{
+			&GenericSecretSpec{Namespace: "bar"},
+			[]string{}, true,
+		},
+		{
+			&GenericSecretSpec{Name: "foo", Namespace: "bar", Type: api.SecretTypeTLS,
+				Data: map[string][]byte{api.TLSCertKey: []byte("cert")}},
+			[]string{}, true,
+		},
+		{
+			&GenericSecretSpec{Name: "foo", Namespace: "bar", Type: api.SecretTypeTLS,
+				Data: map[string][]byte{
+					api.TLSCertKey:       []byte("cert"),
+					api.TLSPrivateKeyKey: []byte("key"),
+				}},
+			[]string{"create"}, false,
+		},
+		{
+			&GenericSecretSpec{Name: "foo", Namespace: "bar",
+				Type: api.SecretTypeServiceAccountToken},
+			[]string{}, true,
+		},
+		{
+			&GenericSecretSpec{Name: "foo", Namespace: "bar",
+				Type: api.SecretTypeServiceAccountToken, ServiceAccountName: "default"},
+			[]string{"create"}, false,
+		},
+		{
+			&GenericSecretSpec{Name: "foo", Namespace: "bar", Type: "unknown"},
+			[]string{}, true,
+		},
+		{
+			&ImagePullSecretSpec{Name: "foo", Namespace: "bar", Data: []byte("config")},
+			[]string{"create"}, false,
+		},
+	}
+
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake()
+
+		_, err := CreateSecret(fakeClient, c.spec)
+
+		if k8serrors.IsBadRequest(err) != c.expectedBadReq {
+			t.Errorf("CreateSecret(client, %#v) returned error %v, expected bad request: %t",
+				c.spec, err, c.expectedBadReq)
+		}
+
+		actions := fakeClient.Actions()
+		if len(actions) != len(c.expectedActions) {
+			t.Errorf("Unexpected actions: %v, expected %d actions got %d", actions,
+				len(c.expectedActions), len(actions))
+			continue
+		}
+
+		for i, verb := range c.expectedActions {
+			if actions[i].GetVerb() != verb {
+				t.Errorf("Unexpected action: %+v, expected %s", actions[i], verb)
+			}
+		}
+	}
+}
+
+func TestCreateSecretServiceAccountAnnotation(t *testing.T) {
+	fakeClient := testclient.NewSimpleFake()
+	spec := &GenericSecretSpec{Name: "foo", Namespace: "bar",
+		Type: api.SecretTypeServiceAccountToken, ServiceAccountName: "default"}
+
+	CreateSecret(fakeClient, spec)
+
+	created := fakeClient.Actions()[0].(testclient.CreateAction).GetObject().(*api.Secret)
+	expected := map[string]string{api.ServiceAccountNameKey: "default"}
+	if !reflect.DeepEqual(created.Annotations, expected) {
+		t.Errorf("Created secret has annotations %#v, expected %#v", created.Annotations,
+			expected)
+	}
+}
+
+func TestUpdateSecret(t *testing.T) {
+	cases := []struct {
+		secret          *api.Secret
+		spec            SecretSpec
+		expectedActions []string
+		expectedData    map[string][]byte
+	}{
+		{
+			&api.Secret{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Type:       api.SecretTypeOpaque,
+				Data:       map[string][]byte{"old": []byte("value")},
+			},
+			&GenericSecretSpec{Name: "foo", Namespace: "bar",
+				Data: map[string][]byte{"new": []byte("value")}},
+			[]string{"get", "update"},
+			map[string][]byte{"new": []byte("value")},
+		},
+		{
+			&api.Secret{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Type:       api.SecretTypeOpaque,
+			},
+			&GenericSecretSpec{Name: "foo", Namespace: "bar", Type: api.SecretTypeTLS,
+				Data: map[string][]byte{
+					api.TLSCertKey:       []byte("cert"),
+					api.TLSPrivateKeyKey: []byte("key"),
+				}},
+			[]string{"get"},
+			nil,
+		},
+	}
+
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake(c.secret)
+
+		UpdateSecret(fakeClient, "bar", "foo", c.spec)
+
+		actions := fakeClient.Actions()
+		if len(actions) != len(c.expectedActions) {
+			t.Errorf("Unexpected actions: %v, expected %d actions got %d", actions,
+				len(c.expectedActions), len(actions))
+			continue
+		}
+
+		for i, verb := range c.expectedActions {
+			if actions[i].GetVerb() != verb {
+				t.Errorf("Unexpected action: %+v, expected %s", actions[i], verb)
+			}
+		}
+
+		if c.expectedData != nil {
+			updated := actions[1].(testclient.UpdateAction).GetObject().(*api.Secret)
+			if !reflect.DeepEqual(updated.Data, c.expectedData) {
+				t.Errorf("Updated secret has data %#v, expected %#v", updated.Data,
+					c.expectedData)
+			}
+		}
+	}
+}
+
+func TestDeleteSecret(t *testing.T) {
+	fakeClient := testclient.NewSimpleFake(&api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+	})
+
+	DeleteSecret(fakeClient, "bar", "foo")
+
+	actions := fakeClient.Actions()
+	if len(actions) != 1 || actions[0].GetVerb() != "delete" ||
+		actions[0].(testclient.DeleteAction).GetName() != "foo" {
+		t.Errorf("Unexpected actions: %v, expected delete of foo secret", actions)
+	}
+}
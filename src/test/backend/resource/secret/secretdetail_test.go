@@ -0,0 +1,122 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes/dashboard/resource/common"
+	"k8s.io/kubernetes/pkg/api"
+	k8serrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func TestGetSecretDetail(t *testing.T) {
+	secret := &api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Type:       api.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"password": []byte("secret"),
+			"config":   []byte("{}"),
+		},
+	}
+	pods := &api.PodList{Items: []api.Pod{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "mounting-pod"},
+			Spec: api.PodSpec{Volumes: []api.Volume{{
+				Name: "secret-volume",
+				VolumeSource: api.VolumeSource{
+					Secret: &api.SecretVolumeSource{SecretName: "foo"},
+				},
+			}}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "unrelated-pod"},
+			Spec: api.PodSpec{Volumes: []api.Volume{{
+				Name: "other-volume",
+				VolumeSource: api.VolumeSource{
+					Secret: &api.SecretVolumeSource{SecretName: "other"},
+				},
+			}}},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "env-pod"},
+			Spec: api.PodSpec{
+				Containers: []api.Container{{Env: []api.EnvVar{{
+					Name: "PASSWORD",
+					ValueFrom: &api.EnvVarSource{SecretKeyRef: &api.SecretKeySelector{
+						LocalObjectReference: api.LocalObjectReference{Name: "foo"},
+						Key:                  "password",
+					}},
+				}}}},
+				ImagePullSecrets: []api.LocalObjectReference{{Name: "foo"}},
+			},
+		},
+	}}
+	expected := &SecretDetail{
+		ObjectMeta: common.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Type:       api.SecretTypeOpaque,
+		Keys: []SecretKey{
+			{Name: "config", Size: 2},
+			{Name: "password", Size: 6},
+		},
+		Pods: []SecretPodReference{
+			{Name: "mounting-pod", Usages: []SecretUsage{VolumeUsage}},
+			{Name: "env-pod", Usages: []SecretUsage{EnvUsage, ImagePullUsage}},
+		},
+	}
+
+	fakeClient := testclient.NewSimpleFake(secret, pods)
+
+	actual, err := GetSecretDetail(fakeClient, "bar", "foo")
+	if err != nil {
+		t.Fatalf("GetSecretDetail(client, bar, foo) returned error %v", err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetSecretDetail(client, bar, foo) == \ngot %#v, \nexpected %#v", actual,
+			expected)
+	}
+}
+
+func TestGetSecretValue(t *testing.T) {
+	secret := &api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	cases := []struct {
+		key              string
+		expected         *SecretValue
+		expectedNotFound bool
+	}{
+		{"password", &SecretValue{Key: "password", Value: []byte("secret")}, false},
+		{"missing", nil, true},
+	}
+
+	for _, c := range cases {
+		fakeClient := testclient.NewSimpleFake(secret)
+
+		actual, err := GetSecretValue(fakeClient, "bar", "foo", c.key)
+
+		if k8serrors.IsNotFound(err) != c.expectedNotFound {
+			t.Errorf("GetSecretValue(client, bar, foo, %#v) returned error %v", c.key, err)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("GetSecretValue(client, bar, foo, %#v) == %#v, expected %#v", c.key,
+				actual, c.expected)
+		}
+	}
+}
@@ -20,42 +20,93 @@ import (
 
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
 
 	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/pod"
 )
 
 func TestGetServiceDetail(t *testing.T) {
 	cases := []struct {
-		service         *api.Service
+		objects         []runtime.Object
 		namespace, name string
 		expectedActions []string
 		expected        *ServiceDetail
 	}{
 		{
-			service:   &api.Service{},
+			objects:   []runtime.Object{&api.Service{}},
 			namespace: "test-namespace", name: "test-name",
-			expectedActions: []string{"get"},
-			expected:        &ServiceDetail{},
+			expectedActions: []string{"get", "get"},
+			expected: &ServiceDetail{
+				Endpoints: []ServiceEndpoint{},
+				Pods:      pod.PodList{Pods: []pod.Pod{}},
+			},
 		}, {
-			service: &api.Service{ObjectMeta: api.ObjectMeta{
+			objects: []runtime.Object{&api.Service{ObjectMeta: api.ObjectMeta{
 				Name: "test-service", Namespace: "test-namespace",
-			}},
+			}}},
 			namespace: "test-namespace", name: "test-name",
-			expectedActions: []string{"get"},
+			expectedActions: []string{"get", "get"},
+			expected: &ServiceDetail{
+				ObjectMeta: common.ObjectMeta{
+					Name:      "test-service",
+					Namespace: "test-namespace",
+				},
+				InternalEndpoint: common.Endpoint{Host: "test-service.test-namespace"},
+				Endpoints:        []ServiceEndpoint{},
+				Pods:             pod.PodList{Pods: []pod.Pod{}},
+			},
+		}, {
+			objects: []runtime.Object{
+				&api.Service{
+					ObjectMeta: api.ObjectMeta{Name: "test-service", Namespace: "test-namespace"},
+					Spec: api.ServiceSpec{
+						Type:     api.ServiceTypeNodePort,
+						Selector: map[string]string{"app": "test"},
+					},
+				},
+				&api.Endpoints{
+					ObjectMeta: api.ObjectMeta{Name: "test-service", Namespace: "test-namespace"},
+					Subsets: []api.EndpointSubset{{
+						Addresses:         []api.EndpointAddress{{IP: "10.0.0.1"}},
+						NotReadyAddresses: []api.EndpointAddress{{IP: "10.0.0.2"}},
+						Ports:             []api.EndpointPort{{Port: 8080, Protocol: "TCP"}},
+					}},
+				},
+				&api.PodList{},
+				&api.NodeList{},
+			},
+			namespace: "test-namespace", name: "test-service",
+			expectedActions: []string{"get", "get", "list", "list"},
 			expected: &ServiceDetail{
 				ObjectMeta: common.ObjectMeta{
 					Name:      "test-service",
 					Namespace: "test-namespace",
 				},
 				InternalEndpoint: common.Endpoint{Host: "test-service.test-namespace"},
+				Selector:         map[string]string{"app": "test"},
+				Type:             api.ServiceTypeNodePort,
+				Endpoints: []ServiceEndpoint{
+					{
+						Host:  "10.0.0.1",
+						Ports: []api.EndpointPort{{Port: 8080, Protocol: "TCP"}},
+						Ready: true,
+					},
+					{
+						Host:  "10.0.0.2",
+						Ports: []api.EndpointPort{{Port: 8080, Protocol: "TCP"}},
+						Ready: false,
+					},
+				},
+				Pods: pod.PodList{Pods: []pod.Pod{}},
 			},
 		},
 	}
 
 	for _, c := range cases {
-		fakeClient := testclient.NewSimpleFake(c.service)
+		fakeClient := testclient.NewSimpleFake(c.objects...)
 
-		actual, _ := GetServiceDetail(fakeClient, c.namespace, c.name)
+		actual, _ := GetServiceDetail(fakeClient, nil, c.namespace, c.name)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -26,15 +26,18 @@ import (
 
 func TestGetServiceList(t *testing.T) {
 	cases := []struct {
+		namespace       string
 		serviceList     *api.ServiceList
 		expectedActions []string
 		expected        *ServiceList
 	}{
 		{
+			namespace:       api.NamespaceAll,
 			serviceList:     &api.ServiceList{},
 			expectedActions: []string{"list"},
 			expected:        &ServiceList{Services: make([]Service, 0)},
 		}, {
+			namespace: "test-namespace",
 			serviceList: &api.ServiceList{
 				Items: []api.Service{
 					{ObjectMeta: api.ObjectMeta{
@@ -43,6 +46,7 @@ func TestGetServiceList(t *testing.T) {
 				}},
 			expectedActions: []string{"list"},
 			expected: &ServiceList{
+				ListMeta: common.ListMeta{TotalItems: 1},
 				Services: []Service{
 					{
 						ObjectMeta: common.ObjectMeta{
@@ -59,7 +63,7 @@ func TestGetServiceList(t *testing.T) {
 	for _, c := range cases {
 		fakeClient := testclient.NewSimpleFake(c.serviceList)
 
-		actual, _ := GetServiceList(fakeClient)
+		actual, _ := GetServiceList(fakeClient, c.namespace, common.NoDataSelect)
 
 		actions := fakeClient.Actions()
 		if len(actions) != len(c.expectedActions) {
@@ -73,6 +77,10 @@ func TestGetServiceList(t *testing.T) {
 				t.Errorf("Unexpected action: %+v, expected %s",
 					actions[i], verb)
 			}
+			if actions[i].GetNamespace() != c.namespace {
+				t.Errorf("Unexpected action namespace: %s, expected %s",
+					actions[i].GetNamespace(), c.namespace)
+			}
 		}
 
 		if !reflect.DeepEqual(actual, c.expected) {
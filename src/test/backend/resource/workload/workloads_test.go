@@ -22,8 +22,10 @@ import (
 	"k8s.io/kubernetes/pkg/apis/extensions"
 
 	"github.com/kubernetes/dashboard/resource/common"
+	"github.com/kubernetes/dashboard/resource/daemonset"
 	"github.com/kubernetes/dashboard/resource/deployment"
 	"github.com/kubernetes/dashboard/resource/event"
+	"github.com/kubernetes/dashboard/resource/job"
 	"github.com/kubernetes/dashboard/resource/pod"
 	"github.com/kubernetes/dashboard/resource/replicaset"
 	"github.com/kubernetes/dashboard/resource/replicationcontroller"
@@ -35,20 +37,28 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 		k8sDeployment extensions.DeploymentList
 		k8sRc         api.ReplicationControllerList
 		k8sPod        api.PodList
+		k8sJob        extensions.JobList
+		k8sDaemonSet  extensions.DaemonSetList
 		rcs           []replicationcontroller.ReplicationController
 		rs            []replicaset.ReplicaSet
 		deployment    []deployment.Deployment
 		pod           []pod.Pod
+		job           []job.Job
+		daemonSet     []daemonset.DaemonSet
 	}{
 		{
 			extensions.ReplicaSetList{},
 			extensions.DeploymentList{},
 			api.ReplicationControllerList{},
 			api.PodList{},
+			extensions.JobList{},
+			extensions.DaemonSetList{},
 			[]replicationcontroller.ReplicationController{},
 			[]replicaset.ReplicaSet{},
 			[]deployment.Deployment{},
 			[]pod.Pod{},
+			[]job.Job{},
+			[]daemonset.DaemonSet{},
 		},
 		{
 			extensions.ReplicaSetList{
@@ -66,6 +76,21 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 				}},
 			},
 			api.PodList{},
+			extensions.JobList{
+				Items: []extensions.Job{{
+					ObjectMeta: api.ObjectMeta{Name: "job-name"},
+					Status:     extensions.JobStatus{Succeeded: 1},
+				}},
+			},
+			extensions.DaemonSetList{
+				Items: []extensions.DaemonSet{{
+					ObjectMeta: api.ObjectMeta{Name: "ds-name"},
+					Status: extensions.DaemonSetStatus{
+						DesiredNumberScheduled: 3,
+						CurrentNumberScheduled: 2,
+					},
+				}},
+			},
 			[]replicationcontroller.ReplicationController{{
 				ObjectMeta: common.ObjectMeta{
 					Name: "rc-name",
@@ -91,22 +116,56 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 				},
 			}},
 			[]pod.Pod{},
+			[]job.Job{{
+				ObjectMeta: common.ObjectMeta{
+					Name: "job-name",
+				},
+				Pods: common.PodInfo{
+					Desired:  1,
+					Warnings: []event.Event{},
+				},
+				Succeeded: 1,
+			}},
+			[]daemonset.DaemonSet{{
+				ObjectMeta: common.ObjectMeta{
+					Name: "ds-name",
+				},
+				Pods: common.PodInfo{
+					Current:  2,
+					Desired:  3,
+					Warnings: []event.Event{},
+				},
+				DesiredNumberScheduled: 3,
+				CurrentNumberScheduled: 2,
+			}},
 		},
 	}
 
 	for _, c := range cases {
 		expected := &Workloads{
 			ReplicationControllerList: replicationcontroller.ReplicationControllerList{
+				ListMeta:               common.ListMeta{TotalItems: len(c.rcs)},
 				ReplicationControllers: c.rcs,
 			},
 			ReplicaSetList: replicaset.ReplicaSetList{
+				ListMeta:    common.ListMeta{TotalItems: len(c.rs)},
 				ReplicaSets: c.rs,
 			},
 			DeploymentList: deployment.DeploymentList{
+				ListMeta:    common.ListMeta{TotalItems: len(c.deployment)},
 				Deployments: c.deployment,
 			},
 			PodList: pod.PodList{
-				Pods: c.pod,
+				ListMeta: common.ListMeta{TotalItems: len(c.pod)},
+				Pods:     c.pod,
+			},
+			JobList: job.JobList{
+				ListMeta: common.ListMeta{TotalItems: len(c.job)},
+				Jobs:     c.job,
+			},
+			DaemonSetList: daemonset.DaemonSetList{
+				ListMeta:   common.ListMeta{TotalItems: len(c.daemonSet)},
+				DaemonSets: c.daemonSet,
 			},
 		}
 		var expectedErr error = nil
@@ -132,13 +191,21 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 				List:  make(chan *api.ServiceList, 3),
 				Error: make(chan error, 3),
 			},
+			JobList: common.JobListChannel{
+				List:  make(chan *extensions.JobList, 1),
+				Error: make(chan error, 1),
+			},
+			DaemonSetList: common.DaemonSetListChannel{
+				List:  make(chan *extensions.DaemonSetList, 1),
+				Error: make(chan error, 1),
+			},
 			PodList: common.PodListChannel{
-				List:  make(chan *api.PodList, 4),
-				Error: make(chan error, 4),
+				List:  make(chan *api.PodList, 6),
+				Error: make(chan error, 6),
 			},
 			EventList: common.EventListChannel{
-				List:  make(chan *api.EventList, 3),
-				Error: make(chan error, 3),
+				List:  make(chan *api.EventList, 5),
+				Error: make(chan error, 5),
 			},
 		}
 
@@ -151,6 +218,12 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 		channels.ReplicationControllerList.List <- &c.k8sRc
 		channels.ReplicationControllerList.Error <- nil
 
+		channels.JobList.List <- &c.k8sJob
+		channels.JobList.Error <- nil
+
+		channels.DaemonSetList.List <- &c.k8sDaemonSet
+		channels.DaemonSetList.Error <- nil
+
 		nodeList := &api.NodeList{}
 		channels.NodeList.List <- nodeList
 		channels.NodeList.Error <- nil
@@ -176,6 +249,10 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 		channels.PodList.Error <- nil
 		channels.PodList.List <- podList
 		channels.PodList.Error <- nil
+		channels.PodList.List <- podList
+		channels.PodList.Error <- nil
+		channels.PodList.List <- podList
+		channels.PodList.Error <- nil
 
 		eventList := &api.EventList{}
 		channels.EventList.List <- eventList
@@ -184,8 +261,12 @@ func TestGetWorkloadsFromChannels(t *testing.T) {
 		channels.EventList.Error <- nil
 		channels.EventList.List <- eventList
 		channels.EventList.Error <- nil
+		channels.EventList.List <- eventList
+		channels.EventList.Error <- nil
+		channels.EventList.List <- eventList
+		channels.EventList.Error <- nil
 
-		actual, err := GetWorkloadsFromChannels(channels, nil)
+		actual, err := GetWorkloadsFromChannels(channels, nil, common.NoDataSelect)
 		if !reflect.DeepEqual(actual, expected) {
 			t.Errorf("GetWorkloadsFromChannels() ==\n          %#v\nExpected: %#v", actual, expected)
 		}
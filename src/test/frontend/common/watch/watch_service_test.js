@@ -0,0 +1,91 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import watchModule from 'common/watch/watch_module';
+import {notificationDelayMs} from 'common/watch/watch_service';
+
+describe('Watch service', () => {
+  /** @type {!common/watch/watch_service.WatchService} */
+  let watchService;
+  /** @type {!angular.$window} */
+  let window;
+  /** @type {!angular.$timeout} */
+  let timeout;
+  /** @type {!angular.Scope} */
+  let scope;
+  /** @type {!Object} */
+  let eventSource;
+
+  beforeEach(() => {
+    angular.mock.module(watchModule.name);
+    angular.mock.inject((kdWatchService, $window, $timeout, $rootScope) => {
+      watchService = kdWatchService;
+      window = $window;
+      timeout = $timeout;
+      scope = $rootScope.$new();
+    });
+    eventSource = jasmine.createSpyObj('EventSource', ['addEventListener', 'close']);
+    spyOn(window, 'EventSource').and.returnValue(eventSource);
+  });
+
+  it('should watch resources in the namespace matching the label selector', () => {
+    watchService.watch(scope, 'pods', 'foo-namespace', {app: 'foo', tier: 'web'}, () => {});
+
+    expect(window.EventSource)
+        .toHaveBeenCalledWith(
+            'api/v1/watch/pods/foo-namespace?labelSelector=app%3Dfoo%2Ctier%3Dweb');
+  });
+
+  it('should watch resources in all namespaces', () => {
+    watchService.watch(scope, 'nodes', '', {}, () => {});
+
+    expect(window.EventSource).toHaveBeenCalledWith('api/v1/watch/nodes');
+  });
+
+  it('should call the callback once for changes in short succession', () => {
+    let callback = jasmine.createSpy('callback');
+    watchService.watch(scope, 'pods', 'foo-namespace', {}, callback);
+
+    // when
+    eventSource.onmessage();
+    eventSource.onmessage();
+    timeout.flush(notificationDelayMs - 1);
+
+    // then
+    expect(callback).not.toHaveBeenCalled();
+
+    // when
+    timeout.flush(1);
+    eventSource.onmessage();
+    timeout.flush(notificationDelayMs);
+
+    // then
+    expect(callback.calls.count()).toBe(2);
+  });
+
+  it('should stop watching when the scope is destroyed', () => {
+    let callback = jasmine.createSpy('callback');
+    watchService.watch(scope, 'pods', 'foo-namespace', {}, callback);
+    eventSource.onmessage();
+
+    // when
+    scope.$destroy();
+    timeout.verifyNoPendingTasks();
+
+    // then
+    expect(eventSource.close).toHaveBeenCalled();
+    expect(callback).not.toHaveBeenCalled();
+  });
+});
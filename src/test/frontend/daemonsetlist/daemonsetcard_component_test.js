@@ -0,0 +1,105 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import daemonSetListModule from 'daemonsetlist/daemonsetlist_module';
+
+describe('Daemon Set card', () => {
+  /**
+   * @type {!daemonsetlist/daemonsetcard_component.DaemonSetCardController}
+   */
+  let ctrl;
+
+  beforeEach(() => {
+    angular.mock.module(daemonSetListModule.name);
+
+    angular.mock.inject(
+        ($componentController) => { ctrl = $componentController('kdDaemonSetCard'); });
+  });
+
+  it('should construct details href', () => {
+    // given
+    ctrl.daemonSet = {
+      objectMeta: {
+        name: 'foo-name',
+        namespace: 'foo-namespace',
+      },
+    };
+
+    // then
+    expect(ctrl.getDaemonSetDetailHref()).toEqual('#/daemonsets/foo-namespace/foo-name');
+  });
+
+  it('should have warnings when any pod has warning', () => {
+    // given
+    ctrl.daemonSet = {
+      pods: {
+        warnings: [{
+          message: 'test-error',
+          reason: 'test-reason',
+        }],
+      },
+    };
+
+    // then
+    expect(ctrl.hasWarnings()).toBe(true);
+    expect(ctrl.isPending()).toBe(false);
+    expect(ctrl.isSuccess()).toBe(false);
+  });
+
+  it('should be pending when not scheduled to all desired nodes', () => {
+    // given
+    ctrl.daemonSet = {
+      pods: {
+        warnings: [],
+        pending: 0,
+      },
+      currentNumberScheduled: 2,
+      desiredNumberScheduled: 3,
+    };
+
+    // then
+    expect(ctrl.isPending()).toBe(true);
+    expect(ctrl.isSuccess()).toBe(false);
+  });
+
+  it('should be pending when any pod is pending', () => {
+    // given
+    ctrl.daemonSet = {
+      pods: {
+        warnings: [],
+        pending: 1,
+      },
+      currentNumberScheduled: 3,
+      desiredNumberScheduled: 3,
+    };
+
+    // then
+    expect(ctrl.isPending()).toBe(true);
+  });
+
+  it('should succeed when scheduled to all desired nodes without warnings', () => {
+    // given
+    ctrl.daemonSet = {
+      pods: {
+        warnings: [],
+        pending: 0,
+      },
+      currentNumberScheduled: 3,
+      desiredNumberScheduled: 3,
+    };
+
+    // then
+    expect(ctrl.isSuccess()).toBe(true);
+  });
+});
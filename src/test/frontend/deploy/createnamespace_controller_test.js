@@ -97,6 +97,26 @@ describe('Create-Namespace dialog', () => {
     expect(httpBackend.flush).toThrow();
   });
 
+  it('should send labels and annotations with non-empty keys', () => {
+    ctrl.namespaceForm = {};
+    ctrl.namespaceForm.$valid = true;
+    ctrl.namespace = 'my-namespace';
+    ctrl.labels = [{key: 'team', value: 'foo'}, {key: '', value: 'ignored'}];
+    ctrl.addEntry(ctrl.annotations);
+    ctrl.annotations[1] = {key: 'owner', value: 'foo@example.com'};
+    ctrl.removeEntry(ctrl.annotations, 0);
+
+    httpBackend
+        .expectPOST('api/v1/namespaces', {
+          name: 'my-namespace',
+          labels: {team: 'foo'},
+          annotations: {owner: 'foo@example.com'},
+        })
+        .respond(201, {});
+    ctrl.createNamespace();
+    httpBackend.flush();
+  });
+
   it('should hide creation dialog and open an error dialog if namespace cannot be created', () => {
     spyOn(ctrl.errorDialog_, 'open');
     spyOn(ctrl.mdDialog_, 'hide');
@@ -106,7 +126,8 @@ describe('Create-Namespace dialog', () => {
     /** @type {string} */
     let errorMessage = 'Something bad happened';
     // return an erranous response
-    httpBackend.expectPOST('api/v1/namespaces').respond(500, errorMessage);
+    httpBackend.expectPOST('api/v1/namespaces')
+        .respond(500, {code: 500, reason: 'InternalError', message: errorMessage});
     // when
     ctrl.createNamespace();
     httpBackend.flush();
@@ -121,7 +121,8 @@ describe('Create-Secret dialog', () => {
     /** @type {string} */
     let errorMessage = 'Something bad happened';
     // return an erranous response
-    httpBackend.expectPOST('api/v1/secrets').respond(500, errorMessage);
+    httpBackend.expectPOST('api/v1/secrets')
+        .respond(500, {code: 500, reason: 'InternalError', message: errorMessage});
     // when
     ctrl.createSecret();
     httpBackend.flush();
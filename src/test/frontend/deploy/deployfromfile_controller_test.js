@@ -99,7 +99,11 @@ describe('DeployFromFile controller', () => {
     it('should not redirect the page and but open error dialog', () => {
       spyOn(ctrl.errorDialog_, 'open');
       spyOn(ctrl.state_, 'go');
-      httpBackend.expectPOST('api/v1/appdeploymentfromfile').respond(500, 'Deployment failed');
+      httpBackend.expectPOST('api/v1/appdeploymentfromfile').respond(500, {
+        code: 500,
+        reason: 'InternalError',
+        message: 'Deployment failed',
+      });
       // when
       ctrl.deploy();
       httpBackend.flush();
@@ -16,6 +16,7 @@ import DeployFromSettingController from 'deploy/deployfromsettings_controller';
 import deployModule from 'deploy/deploy_module';
 import DeployLabel from 'deploy/deploylabel';
 import {uniqueNameValidationKey} from 'deploy/uniquename_directive';
+import {stateName as workloadsState} from 'workloads/workloads_state';
 
 describe('DeployFromSettings controller', () => {
   /** @type {!DeployFromSettingController} */
@@ -250,6 +251,112 @@ describe('DeployFromSettings controller', () => {
     expect(resourceObject.save).toHaveBeenCalled();
   });
 
+  it('should deploy as job when run to completion is set', () => {
+    // given
+    let resourceObject = {
+      save: jasmine.createSpy('save'),
+    };
+    mockResource.and.returnValue(resourceObject);
+    resourceObject.save.and.callFake(function(spec) {
+      // then
+      expect(spec.kind).toBe('Job');
+    });
+    ctrl.runToCompletion = true;
+
+    // when
+    ctrl.deploy();
+
+    // then
+    expect(resourceObject.save).toHaveBeenCalled();
+  });
+
+  it('should deploy as chosen kind without rolling update by default', () => {
+    // given
+    let resourceObject = {
+      save: jasmine.createSpy('save'),
+    };
+    mockResource.and.returnValue(resourceObject);
+    resourceObject.save.and.callFake(function(spec) {
+      // then
+      expect(spec.kind).toBe('DaemonSet');
+      expect(spec.rollingUpdateStrategy).toBe(null);
+    });
+    ctrl.kind = 'DaemonSet';
+
+    // when
+    ctrl.deploy();
+
+    // then
+    expect(resourceObject.save).toHaveBeenCalled();
+  });
+
+  it('should let the server choose kind when none is chosen', () => {
+    // given
+    let resourceObject = {
+      save: jasmine.createSpy('save'),
+    };
+    mockResource.and.returnValue(resourceObject);
+    resourceObject.save.and.callFake(function(spec) {
+      // then
+      expect(spec.kind).toBe(null);
+      expect(spec.rollingUpdateStrategy).toBe(null);
+    });
+
+    // when
+    ctrl.deploy();
+
+    // then
+    expect(resourceObject.save).toHaveBeenCalled();
+  });
+
+  it('should deploy deployment with rolling update strategy', () => {
+    // given
+    let resourceObject = {
+      save: jasmine.createSpy('save'),
+    };
+    mockResource.and.returnValue(resourceObject);
+    resourceObject.save.and.callFake(function(spec) {
+      // then
+      expect(spec.kind).toBe('Deployment');
+      expect(spec.rollingUpdateStrategy).toEqual({maxSurge: 2, maxUnavailable: '25%'});
+    });
+    ctrl.kind = 'Deployment';
+    ctrl.maxSurge = '2';
+    ctrl.maxUnavailable = '25%';
+
+    // when
+    ctrl.deploy();
+
+    // then
+    expect(resourceObject.save).toHaveBeenCalled();
+  });
+
+  it('should enable rolling update only for deployments that do not run to completion', () => {
+    expect(ctrl.isRollingUpdateEnabled()).toBe(false);
+
+    ctrl.kind = 'Deployment';
+    expect(ctrl.isRollingUpdateEnabled()).toBe(true);
+
+    ctrl.runToCompletion = true;
+    expect(ctrl.isRollingUpdateEnabled()).toBe(false);
+  });
+
+  it('should go to workloads after successful deploy', () => {
+    // given
+    let resourceObject = {
+      save: jasmine.createSpy('save'),
+    };
+    mockResource.and.returnValue(resourceObject);
+    resourceObject.save.and.callFake(function(spec, success) { success(spec); });
+    spyOn(ctrl.state_, 'go');
+
+    // when
+    ctrl.deploy();
+
+    // then
+    expect(ctrl.state_.go).toHaveBeenCalledWith(workloadsState);
+  });
+
   it('should hide more options by default', () => {
     // this is default behavior so no given/when
     // then
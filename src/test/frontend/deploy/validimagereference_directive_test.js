@@ -70,7 +70,7 @@ describe('Valid ImageReference directive', () => {
 
     let elem = compileFn(scope)[0];
     httpBackend.when('POST', 'api/v1/appdeployments/validate/imagereference')
-        .respond(503, 'Service Unavailable');
+        .respond(503, {code: 503, reason: 'ServiceUnavailable', message: 'Service Unavailable'});
     httpBackend.flush();
     expect(elem.classList).not.toContain('ng-pending');
     expect(elem.classList).toContain('ng-invalid');
@@ -0,0 +1,45 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {IngressDetailController} from 'ingressdetail/ingressdetail_controller';
+import ingressDetailModule from 'ingressdetail/ingressdetail_module';
+
+describe('Ingress detail controller', () => {
+  /** @type {!IngressDetailController} */
+  let ctrl;
+
+  beforeEach(() => {
+    angular.mock.module(ingressDetailModule.name);
+
+    angular.mock.inject(($controller) => {
+      ctrl = $controller(
+          IngressDetailController,
+          {ingressDetail: {objectMeta: {name: 'foo-ingress', namespace: 'foo-namespace'}}});
+    });
+  });
+
+  it('should describe backend problems', () => {
+    expect(ctrl.getBackendProblem({status: 'Ok'})).toBe('');
+    expect(ctrl.getBackendProblem({status: 'ServiceNotFound'})).toBe('Service does not exist');
+    expect(ctrl.getBackendProblem({status: 'PortNotFound'}))
+        .toBe('Service does not expose this port');
+    expect(ctrl.getBackendProblem({status: 'NoReadyEndpoints'}))
+        .toBe('Service has no ready endpoints');
+  });
+
+  it('should return backend service details link', () => {
+    expect(ctrl.getServiceDetailHref({serviceName: 'foo-service'}))
+        .toBe('#/services/foo-namespace/foo-service');
+  });
+});
@@ -0,0 +1,38 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import ingressListModule from 'ingresslist/ingresslist_module';
+
+describe('Ingress card list', () => {
+  /**
+   * @type {!ingresslist/ingresscardlist_component.IngressCardListController}
+   */
+  let ctrl;
+
+  beforeEach(() => {
+    angular.mock.module(ingressListModule.name);
+
+    angular.mock.inject(
+        ($componentController) => { ctrl = $componentController('kdIngressCardList', {}, {}); });
+  });
+
+  it('should return ingress details link', () => {
+    expect(ctrl.getIngressDetailHref({
+      objectMeta: {
+        name: 'foo-ingress',
+        namespace: 'foo-namespace',
+      },
+    })).toBe('#/ingresses/foo-namespace/foo-ingress');
+  });
+});
@@ -0,0 +1,95 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import jobListModule from 'joblist/joblist_module';
+
+describe('Job card', () => {
+  /**
+   * @type {!joblist/jobcard_component.JobCardController}
+   */
+  let ctrl;
+
+  beforeEach(() => {
+    angular.mock.module(jobListModule.name);
+
+    angular.mock.inject(($componentController) => { ctrl = $componentController('kdJobCard'); });
+  });
+
+  it('should construct details href', () => {
+    // given
+    ctrl.job = {
+      objectMeta: {
+        name: 'foo-name',
+        namespace: 'foo-namespace',
+      },
+    };
+
+    // then
+    expect(ctrl.getJobDetailHref()).toEqual('#/jobs/foo-namespace/foo-name');
+  });
+
+  it('should default completions to one', () => {
+    // given
+    ctrl.job = {completions: null};
+
+    // then
+    expect(ctrl.getCompletions()).toBe(1);
+
+    // when
+    ctrl.job.completions = 5;
+
+    // then
+    expect(ctrl.getCompletions()).toBe(5);
+  });
+
+  it('should have warnings when any pod failed', () => {
+    // given
+    ctrl.job = {
+      pods: {warnings: []},
+      active: 1,
+      failed: 1,
+    };
+
+    // then
+    expect(ctrl.hasWarnings()).toBe(true);
+    expect(ctrl.isRunning()).toBe(false);
+    expect(ctrl.isSuccess()).toBe(false);
+  });
+
+  it('should be running when pods are active and have no warnings', () => {
+    // given
+    ctrl.job = {
+      pods: {warnings: []},
+      active: 2,
+      failed: 0,
+    };
+
+    // then
+    expect(ctrl.hasWarnings()).toBe(false);
+    expect(ctrl.isRunning()).toBe(true);
+    expect(ctrl.isSuccess()).toBe(false);
+  });
+
+  it('should succeed when no pods are active and there are no warnings', () => {
+    // given
+    ctrl.job = {
+      pods: {warnings: []},
+      active: 0,
+      failed: 0,
+    };
+
+    // then
+    expect(ctrl.isSuccess()).toBe(true);
+  });
+});
@@ -0,0 +1,59 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import {NamespaceDetailController} from 'namespacedetail/namespacedetail_controller';
+import DeleteNamespaceDialogController from 'namespacedetail/deletenamespace_controller';
+import namespaceDetailModule from 'namespacedetail/namespacedetail_module';
+
+describe('Namespace detail controller', () => {
+  /** @type {!backendApi.NamespaceDetail} */
+  let namespaceDetail = {
+    objectMeta: {name: 'foo'},
+    objectCounts: {pods: 2},
+  };
+
+  beforeEach(() => { angular.mock.module(namespaceDetailModule.name); });
+
+  it('should go to workloads after the namespace is deleted',
+     angular.mock.inject(($controller, $q, $rootScope, $mdDialog, $state) => {
+       let deferred = $q.defer();
+       spyOn($mdDialog, 'show').and.returnValue(deferred.promise);
+       spyOn($state, 'go');
+       /** @type {!NamespaceDetailController} */
+       let ctrl = $controller(NamespaceDetailController, {namespaceDetail: namespaceDetail});
+
+       ctrl.handleDeleteNamespaceDialog();
+       deferred.resolve();
+       $rootScope.$digest();
+
+       expect($mdDialog.show).toHaveBeenCalled();
+       expect($state.go).toHaveBeenCalledWith('workloads');
+     }));
+
+  it('should delete the namespace from the dialog',
+     angular.mock.inject(($controller, $httpBackend, $mdDialog) => {
+       spyOn($mdDialog, 'hide');
+       /** @type {!DeleteNamespaceDialogController} */
+       let ctrl = $controller(DeleteNamespaceDialogController, {namespaceDetail: namespaceDetail});
+
+       expect(ctrl.objectCounts.pods).toBe(2);
+
+       $httpBackend.expectDELETE('api/v1/namespaces/foo').respond(200, {});
+       ctrl.remove();
+       $httpBackend.flush();
+
+       expect($mdDialog.hide).toHaveBeenCalled();
+     }));
+});
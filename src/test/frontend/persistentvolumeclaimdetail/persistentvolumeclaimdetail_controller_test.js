@@ -0,0 +1,39 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {PersistentVolumeClaimDetailController} from 'persistentvolumeclaimdetail/persistentvolumeclaimdetail_controller';
+import persistentVolumeClaimDetailModule from 'persistentvolumeclaimdetail/persistentvolumeclaimdetail_module';
+
+describe('Persistent volume claim detail controller', () => {
+
+  beforeEach(() => { angular.mock.module(persistentVolumeClaimDetailModule.name); });
+
+  it('should initialize controller', angular.mock.inject(($controller) => {
+    let data = {status: 'Bound'};
+    /** @type {!PersistentVolumeClaimDetailController} */
+    let ctrl = $controller(
+        PersistentVolumeClaimDetailController, {persistentVolumeClaimDetail: data});
+
+    expect(ctrl.persistentVolumeClaimDetail).toBe(data);
+    expect(ctrl.isUnbound()).toBe(false);
+  }));
+
+  it('should report pending claim as unbound', angular.mock.inject(($controller) => {
+    /** @type {!PersistentVolumeClaimDetailController} */
+    let ctrl = $controller(
+        PersistentVolumeClaimDetailController, {persistentVolumeClaimDetail: {status: 'Pending'}});
+
+    expect(ctrl.isUnbound()).toBe(true);
+  }));
+});
@@ -0,0 +1,40 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import persistentVolumeListModule from 'persistentvolumelist/persistentvolumelist_module';
+
+describe('Persistent volume card list', () => {
+  /**
+   * @type {!persistentvolumelist/persistentvolumecardlist_component.PersistentVolumeCardListController}
+   */
+  let ctrl;
+
+  beforeEach(() => {
+    angular.mock.module(persistentVolumeListModule.name);
+
+    angular.mock.inject(($componentController) => {
+      ctrl = $componentController('kdPersistentVolumeCardList', {}, {});
+    });
+  });
+
+  it('should return persistent volume details link', () => {
+    expect(ctrl.getPersistentVolumeDetailHref({objectMeta: {name: 'foo-volume'}}))
+        .toBe('#/persistentvolumes/foo-volume');
+  });
+
+  it('should return bound claim details link', () => {
+    expect(ctrl.getClaimDetailHref({namespace: 'foo-namespace', name: 'foo-claim'}))
+        .toBe('#/persistentvolumeclaims/foo-namespace/foo-claim');
+  });
+});
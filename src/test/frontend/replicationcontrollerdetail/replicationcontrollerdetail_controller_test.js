@@ -21,20 +21,47 @@ describe('Replication Controller Detail controller', () => {
    * @type {!ReplicationControllerDetailController}
    */
   let ctrl;
+  /** @type {!angular.Scope} */
+  let scope;
+  /** @type {!Object} */
+  let kdWatchService;
+  /** @type {!Object} */
+  let replicationControllerDetailResource;
 
   beforeEach(() => {
     angular.mock.module(replicationControllerDetailModule.name);
 
-    angular.mock.inject(($controller) => {
+    angular.mock.inject(($controller, $rootScope, $q) => {
+      kdWatchService = jasmine.createSpyObj('kdWatchService', ['watch']);
+      replicationControllerDetailResource = jasmine.createSpyObj('$resource', ['get']);
+      replicationControllerDetailResource.get.and.returnValue(
+          {$promise: $q.when({name: 'refreshed'})});
+      scope = $rootScope.$new();
       ctrl = $controller(ReplicationControllerDetailController, {
-        replicationControllerDetail: {},
+        replicationControllerDetail: {labelSelector: {app: 'foo'}},
+        replicationControllerDetailResource: replicationControllerDetailResource,
         replicationControllerEvents: {},
         $stateParams:
             {replicationController: 'foo-replicationcontroller', namespace: 'foo-namespace'},
+        $scope: scope,
+        kdWatchService: kdWatchService,
       });
     });
   });
 
+  it('should refresh when pods of the replication controller change', () => {
+    expect(kdWatchService.watch)
+        .toHaveBeenCalledWith(scope, 'pods', 'foo-namespace', {app: 'foo'}, jasmine.any(Function));
+
+    // when
+    kdWatchService.watch.calls.mostRecent().args[4]();
+    scope.$digest();
+
+    // then
+    expect(replicationControllerDetailResource.get).toHaveBeenCalled();
+    expect(ctrl.replicationControllerDetail).toEqual({name: 'refreshed'});
+  });
+
   it('should show/hide cpu and memory metrics for pods', () => {
     expect(ctrl.hasMemoryUsage({})).toBe(false);
     expect(ctrl.hasMemoryUsage({metrics: {}})).toBe(false);
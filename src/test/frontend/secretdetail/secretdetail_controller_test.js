@@ -0,0 +1,58 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+import {SecretDetailController} from 'secretdetail/secretdetail_controller';
+import secretDetailModule from 'secretdetail/secretdetail_module';
+
+describe('Secret detail controller', () => {
+  /** @type {!SecretDetailController} */
+  let ctrl;
+  /** @type {!angular.$httpBackend} */
+  let httpBackend;
+
+  beforeEach(() => {
+    angular.mock.module(secretDetailModule.name);
+
+    angular.mock.inject(($controller, $httpBackend) => {
+      httpBackend = $httpBackend;
+      ctrl = $controller(SecretDetailController, {
+        secretDetail: {
+          objectMeta: {name: 'foo', namespace: 'bar'},
+          keys: [{name: 'password', size: 6}],
+          pods: [],
+        },
+      });
+    });
+  });
+
+  it('should hide values by default', () => { expect(ctrl.isRevealed('password')).toBe(false); });
+
+  it('should reveal and hide value', () => {
+    httpBackend.expectGET('api/v1/secrets/bar/foo/data/password').respond({
+      key: 'password',
+      value: btoa('secret'),
+    });
+
+    ctrl.reveal('password');
+    httpBackend.flush();
+
+    expect(ctrl.isRevealed('password')).toBe(true);
+    expect(ctrl.values['password']).toBe('secret');
+
+    ctrl.hide('password');
+
+    expect(ctrl.isRevealed('password')).toBe(false);
+  });
+});